@@ -0,0 +1,298 @@
+// Package archive stores application snapshots in a portable, versioned format.
+// A snapshot is written either as a gzip-compressed tar archive or as a directory
+// with the same layout:
+//
+//	metadata.yaml         format version, source app and org, excluded secrets
+//	app.yaml              the application
+//	environments.yaml     its environments
+//	values.yaml           its shared values, without secrets
+//	sets/<env>.yaml       the latest deployment set of each environment
+//	pipelines/<id>.yaml   the definition of each pipeline
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/snapshot"
+	"gopkg.in/yaml.v3"
+)
+
+// APIVersion is the version of the archive format
+const APIVersion = "humctl-wrapper/archive/v1"
+
+// KindAppArchive is the kind of an application archive
+const KindAppArchive = "AppArchive"
+
+// Names of the files in an archive
+const (
+	metadataFile     = "metadata.yaml"
+	appFile          = "app.yaml"
+	environmentsFile = "environments.yaml"
+	valuesFile       = "values.yaml"
+	setsDir          = "sets"
+	pipelinesDir     = "pipelines"
+)
+
+// Metadata describes the contents of an archive
+type Metadata struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	// App is the ID of the exported application
+	App string `yaml:"app"`
+	// Org is the organization the application was exported from
+	Org string `yaml:"org"`
+	// ExcludedSecrets lists the keys of secret shared values, whose values cannot be exported
+	ExcludedSecrets []string `yaml:"excludedSecrets,omitempty"`
+}
+
+// IsArchive reports whether path names a gzip-compressed tar archive rather than a directory
+func IsArchive(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+// Write stores snap, exported from org, at path. Secret shared values are
+// left out and listed in the metadata instead.
+func Write(path string, snap *snapshot.Snapshot, org string) (*Metadata, error) {
+	files, meta, err := encode(snap, org)
+	if err != nil {
+		return nil, err
+	}
+	if IsArchive(path) {
+		err = writeTarGz(path, files)
+	} else {
+		err = writeDir(path, files)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return meta, nil
+}
+
+// Read loads a snapshot from the archive or directory at path
+func Read(path string) (*snapshot.Snapshot, *Metadata, error) {
+	var files map[string][]byte
+	var err error
+	if IsArchive(path) {
+		files, err = readTarGz(path)
+	} else {
+		files, err = readDir(path)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return decode(files)
+}
+
+// encode converts a snapshot into the files of an archive
+func encode(snap *snapshot.Snapshot, org string) (map[string][]byte, *Metadata, error) {
+	meta := &Metadata{APIVersion: APIVersion, Kind: KindAppArchive, App: snap.App.ID, Org: org}
+
+	values := make([]humanitec.Value, 0, len(snap.Values))
+	for _, value := range snap.Values {
+		if value.IsSecret {
+			meta.ExcludedSecrets = append(meta.ExcludedSecrets, value.Key)
+			continue
+		}
+		values = append(values, value)
+	}
+
+	documents := map[string]interface{}{
+		metadataFile:     meta,
+		appFile:          snap.App,
+		environmentsFile: snap.Environments,
+		valuesFile:       values,
+	}
+	for envID, set := range snap.Sets {
+		documents[setsDir+"/"+envID+".yaml"] = set
+	}
+
+	files := make(map[string][]byte, len(documents)+len(snap.Pipelines))
+	for name, doc := range documents {
+		data, err := yaml.Marshal(doc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal %s: %w", name, err)
+		}
+		files[name] = data
+	}
+	for _, pipeline := range snap.Pipelines {
+		files[pipelinesDir+"/"+pipeline.ID+".yaml"] = []byte(pipeline.Definition)
+	}
+	return files, meta, nil
+}
+
+// decode builds a snapshot from the files of an archive
+func decode(files map[string][]byte) (*snapshot.Snapshot, *Metadata, error) {
+	var meta Metadata
+	if err := unmarshal(files, metadataFile, &meta); err != nil {
+		return nil, nil, err
+	}
+	if meta.APIVersion != APIVersion || meta.Kind != KindAppArchive {
+		return nil, nil, clierrors.Validationf("unsupported archive %s/%s: expected %s/%s", meta.APIVersion, meta.Kind, APIVersion, KindAppArchive)
+	}
+
+	snap := &snapshot.Snapshot{}
+	if err := unmarshal(files, appFile, &snap.App); err != nil {
+		return nil, nil, err
+	}
+	if err := unmarshal(files, environmentsFile, &snap.Environments); err != nil {
+		return nil, nil, err
+	}
+	if err := unmarshal(files, valuesFile, &snap.Values); err != nil {
+		return nil, nil, err
+	}
+
+	for _, name := range sortedNames(files) {
+		dir, file := filepath.Split(name)
+		id := strings.TrimSuffix(file, ".yaml")
+		switch strings.TrimSuffix(dir, "/") {
+		case setsDir:
+			var set humanitec.DeploymentSet
+			if err := unmarshal(files, name, &set); err != nil {
+				return nil, nil, err
+			}
+			if snap.Sets == nil {
+				snap.Sets = make(map[string]*humanitec.DeploymentSet)
+			}
+			snap.Sets[id] = &set
+		case pipelinesDir:
+			snap.Pipelines = append(snap.Pipelines, snapshot.PipelineDefinition{ID: id, Definition: string(files[name])})
+		}
+	}
+	return snap, &meta, nil
+}
+
+// unmarshal decodes the YAML file name into v
+func unmarshal(files map[string][]byte, name string, v interface{}) error {
+	data, ok := files[name]
+	if !ok {
+		return clierrors.Validationf("invalid archive: %s is missing", name)
+	}
+	if err := yaml.Unmarshal(data, v); err != nil {
+		return clierrors.Validationf("invalid archive: failed to parse %s: %v", name, err)
+	}
+	return nil
+}
+
+// sortedNames returns the file names in a stable order
+func sortedNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// writeTarGz writes files to a gzip-compressed tar archive. Entries are written in a stable
+// order without timestamps, so exporting the same app twice produces identical archives.
+func writeTarGz(path string, files map[string][]byte) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	for _, name := range sortedNames(files) {
+		data := files[name]
+		header := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), ModTime: time.Unix(0, 0)}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// readTarGz reads the regular files of a gzip-compressed tar archive
+func readTarGz(path string) (map[string][]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[filepath.ToSlash(filepath.Clean(header.Name))] = data
+	}
+}
+
+// writeDir writes files below the directory path, creating it if needed
+func writeDir(path string, files map[string][]byte) error {
+	for _, name := range sortedNames(files) {
+		target := filepath.Join(path, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(target, files[name], 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readDir reads the files of an archive directory
+func readDir(path string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	err := filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(path, p)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = data
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
@@ -0,0 +1,119 @@
+package archive
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWriteRead verifies that a snapshot written to an archive or a directory is read back
+// unchanged, except for secret shared values, which are listed in the metadata instead
+func TestWriteRead(t *testing.T) {
+	snap := &snapshot.Snapshot{
+		App:          humanitec.App{ID: "test-app", Name: "Test App"},
+		Environments: []humanitec.Environment{{ID: "development", Name: "Development", Type: "development"}},
+		Values:       []humanitec.Value{{Key: "REGION", Value: "eu-west-1"}, {Key: "API_KEY", IsSecret: true}},
+		Sets: map[string]*humanitec.DeploymentSet{
+			"development": {ID: "set-1", Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.0"}}},
+		},
+		Pipelines: []snapshot.PipelineDefinition{{ID: "default", Definition: "apiVersion: score.dev/v1b1\nname: default\n"}},
+	}
+
+	for _, name := range []string{"test-app.tar.gz", "test-app"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+
+			written, err := Write(path, snap, "test-org")
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, []string{"API_KEY"}, written.ExcludedSecrets)
+
+			got, meta, err := Read(path)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, written, meta)
+			assert.Equal(t, &Metadata{APIVersion: APIVersion, Kind: KindAppArchive, App: "test-app", Org: "test-org", ExcludedSecrets: []string{"API_KEY"}}, meta)
+			assert.Equal(t, snap.App, got.App)
+			assert.Equal(t, snap.Environments, got.Environments)
+			assert.Equal(t, []humanitec.Value{{Key: "REGION", Value: "eu-west-1"}}, got.Values)
+			assert.Equal(t, snap.Sets, got.Sets)
+			assert.Equal(t, snap.Pipelines, got.Pipelines)
+		})
+	}
+}
+
+// TestWriteIsReproducible verifies that writing the same snapshot twice produces identical archives
+func TestWriteIsReproducible(t *testing.T) {
+	snap := &snapshot.Snapshot{
+		App: humanitec.App{ID: "test-app", Name: "Test App"},
+		Sets: map[string]*humanitec.DeploymentSet{
+			"development": {ID: "set-1"},
+			"production":  {ID: "set-2"},
+		},
+	}
+	dir := t.TempDir()
+
+	var archives [][]byte
+	for _, name := range []string{"first.tar.gz", "second.tar.gz"} {
+		path := filepath.Join(dir, name)
+		_, err := Write(path, snap, "test-org")
+		if !assert.NoError(t, err) {
+			return
+		}
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		archives = append(archives, data)
+	}
+	assert.Equal(t, archives[0], archives[1])
+}
+
+// TestReadInvalid verifies that directories which are not archives of this format are
+// rejected with a validation error
+func TestReadInvalid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		files map[string]string
+	}{
+		{
+			name:  "missing metadata",
+			files: map[string]string{"app.yaml": "id: test-app\n"},
+		},
+		{
+			name:  "unsupported version",
+			files: map[string]string{"metadata.yaml": "apiVersion: humctl-wrapper/archive/v0\nkind: AppArchive\n"},
+		},
+		{
+			name: "missing app",
+			files: map[string]string{
+				"metadata.yaml": "apiVersion: " + APIVersion + "\nkind: " + KindAppArchive + "\n",
+			},
+		},
+		{
+			name: "invalid YAML",
+			files: map[string]string{
+				"metadata.yaml": "apiVersion: " + APIVersion + "\nkind: " + KindAppArchive + "\n",
+				"app.yaml":      "id: [test-app\n",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tc.files {
+				assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+			}
+
+			_, _, err := Read(dir)
+			assert.Error(t, err)
+			assert.Equal(t, clierrors.ExitValidation, clierrors.Classify(err).ExitCode)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+// Package clierrors classifies command errors into stable error codes and
+// process exit codes so that scripts can react to specific failure types.
+package clierrors
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/poll"
+)
+
+// Exit codes returned by the CLI
+const (
+	ExitOK          = 0
+	ExitError       = 1
+	ExitDifferences = 1
+	ExitValidation  = 2
+	ExitNotFound    = 3
+	ExitConflict    = 4
+	ExitAuth        = 5
+	ExitNetwork     = 6
+	ExitTimeout     = 7
+)
+
+// Error codes used in machine-readable error output
+const (
+	CodeInternal     = "Internal"
+	CodeValidation   = "Validation"
+	CodeNotFound     = "NotFound"
+	CodeConflict     = "Conflict"
+	CodeUnauthorized = "Unauthorized"
+	CodeNetwork      = "Network"
+	CodeTimeout      = "Timeout"
+)
+
+// ErrDifferences is returned by diff commands when the compared states differ.
+// Like diff(1), differences exit with status 1 so they can gate CI pipelines.
+// ExitDifferences therefore equals ExitError; differences are told apart from
+// unclassified errors by printing no error message.
+var ErrDifferences = errors.New("differences found")
+
+// usagePrefixes are the messages cobra uses for argument and flag errors,
+// which it does not expose as typed errors
+var usagePrefixes = []string{
+	"required flag(s)",
+	"unknown flag",
+	"unknown shorthand flag",
+	"unknown command",
+	"invalid argument",
+	"flag needs an argument",
+	"accepts ",
+	"requires at least",
+	"requires at most",
+}
+
+// ValidationError is returned when user input is rejected before calling the API
+type ValidationError struct {
+	Err error
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// NewValidation wraps err as a validation error
+func NewValidation(err error) error {
+	return &ValidationError{Err: err}
+}
+
+// Validationf formats a validation error message
+func Validationf(format string, args ...interface{}) error {
+	return &ValidationError{Err: fmt.Errorf(format, args...)}
+}
+
+// AuthError is returned when credentials are missing before calling the API
+type AuthError struct {
+	Err error
+}
+
+// Error implements the error interface
+func (e *AuthError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// NewAuth wraps err as an authentication error
+func NewAuth(err error) error {
+	return &AuthError{Err: err}
+}
+
+// Classification describes how an error is reported to the user
+type Classification struct {
+	// Code is the stable, machine-readable error code
+	Code string
+	// ExitCode is the process exit code
+	ExitCode int
+	// Status is the HTTP status returned by the API, or 0 if the error did not come from the API
+	Status int
+}
+
+// Classify determines the error code, exit code and HTTP status for err
+func Classify(err error) Classification {
+	if err == nil {
+		return Classification{ExitCode: ExitOK}
+	}
+
+	var apiErr *humanitec.APIError
+	if errors.As(err, &apiErr) {
+		c := Classification{Code: CodeInternal, ExitCode: ExitError, Status: apiErr.StatusCode}
+		switch apiErr.StatusCode {
+		case http.StatusNotFound:
+			c.Code, c.ExitCode = CodeNotFound, ExitNotFound
+		case http.StatusConflict:
+			c.Code, c.ExitCode = CodeConflict, ExitConflict
+		case http.StatusUnauthorized, http.StatusForbidden:
+			c.Code, c.ExitCode = CodeUnauthorized, ExitAuth
+		case http.StatusBadRequest, http.StatusUnprocessableEntity:
+			c.Code, c.ExitCode = CodeValidation, ExitValidation
+		}
+		return c
+	}
+
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) || isUsageError(err) {
+		return Classification{Code: CodeValidation, ExitCode: ExitValidation}
+	}
+
+	if errors.Is(err, poll.ErrTimeout) {
+		return Classification{Code: CodeTimeout, ExitCode: ExitTimeout}
+	}
+
+	var authErr *AuthError
+	if errors.As(err, &authErr) || errors.Is(err, humanitec.ErrMissingAPIToken) {
+		return Classification{Code: CodeUnauthorized, ExitCode: ExitAuth}
+	}
+
+	// File errors wrap a syscall.Errno, which also satisfies net.Error
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return Classification{Code: CodeInternal, ExitCode: ExitError}
+	}
+
+	var urlErr *url.Error
+	var netErr net.Error
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+		return Classification{Code: CodeNetwork, ExitCode: ExitNetwork}
+	}
+
+	return Classification{Code: CodeInternal, ExitCode: ExitError}
+}
+
+// ExitCode returns the process exit code for err
+func ExitCode(err error) int {
+	return Classify(err).ExitCode
+}
+
+// isUsageError reports whether err is one of cobra's argument or flag errors
+func isUsageError(err error) bool {
+	msg := err.Error()
+	for _, prefix := range usagePrefixes {
+		if strings.HasPrefix(msg, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// SilentError is returned when a command has already reported its failure
+// in its output. It is not printed again but still sets the exit code.
+type SilentError struct {
+	Err error
+}
+
+// Error implements the error interface
+func (e *SilentError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *SilentError) Unwrap() error {
+	return e.Err
+}
+
+// NewSilent wraps err so that it is not printed again
+func NewSilent(err error) error {
+	return &SilentError{Err: err}
+}
+
+// IsSilent reports whether err has already been reported to the user
+func IsSilent(err error) bool {
+	var silentErr *SilentError
+	return errors.As(err, &silentErr)
+}
@@ -0,0 +1,193 @@
+package apply
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/manifest"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/spf13/cobra"
+)
+
+// appliedStatus is the result status reported for each successfully executed action
+var appliedStatus = map[manifest.ActionType]string{
+	manifest.ActionCreate: "created",
+	manifest.ActionUpdate: "updated",
+	manifest.ActionNone:   "unchanged",
+	manifest.ActionDelete: "deleted",
+}
+
+var apply = &cobra.Command{
+	Use:   constants.ApplyCmdUse,
+	Short: constants.ApplyCmdShort,
+	Long: `Apply application manifests to the organization.
+Manifests are read from a YAML file, a directory of YAML files, or standard input ("-").
+Each application is created if it does not exist, updated if its live state differs from
+the manifest and left unchanged otherwise. With --prune, applications that exist in the
+organization but have no manifest are deleted; --prune is refused if no manifests were found.
+
+Before pruning, the applications to delete are listed and the organization ID must be typed
+to confirm. Use --yes to skip the confirmation in automation, and --dry-run to print the
+requests without sending them.
+
+Example manifest:
+
+  kind: App
+  id: my-app
+  name: My Application`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Get flags
+		filename, err := cmd.Flags().GetString(constants.FilenameFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get filename flag: %w", err)
+		}
+
+		prune, err := cmd.Flags().GetBool(constants.PruneFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get prune flag: %w", err)
+		}
+
+		yes, err := cmd.Flags().GetBool(constants.YesFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get yes flag: %w", err)
+		}
+
+		dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get dry-run flag: %w", err)
+		}
+
+		outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get output format flag: %w", err)
+		}
+
+		// Validate output format
+		outputFormat, err := output.ValidateFormat(outputFormatStr)
+		if err != nil {
+			return fmt.Errorf("invalid output format: %w", err)
+		}
+
+		manifests, err := manifest.Load(filename, cmd.InOrStdin())
+		if err != nil {
+			return err
+		}
+
+		// Pruning against an empty manifest set would delete every application
+		if prune && len(manifests) == 0 {
+			return clierrors.Validationf("refusing to --%s: no manifests found in %s", constants.PruneFlagName, filename)
+		}
+
+		// Get organization ID from the flag or config
+		org, err := apps.OrgID(cmd)
+		if err != nil {
+			return err
+		}
+		token := config.GetConfig().HumanitecToken
+
+		// Create Humanitec client
+		client := humanitec.NewClient(token, org)
+
+		plan, err := manifest.Plan(client, manifests, prune)
+		if err != nil {
+			return fmt.Errorf("failed to plan changes: %w", err)
+		}
+
+		// Print the requests instead of sending them on a dry run
+		if dryRun {
+			var requests []humanitec.Request
+			for _, action := range plan {
+				if req, ok := manifest.Request(org, action); ok {
+					requests = append(requests, req)
+				}
+			}
+			return apps.PrintRequests(cmd, requests, outputFormat)
+		}
+
+		// Ask for confirmation before deleting anything
+		if err := confirmPrune(cmd, org, plan, yes); err != nil {
+			return err
+		}
+
+		// Execute every action, reporting failures without aborting the rest
+		results := make([]output.Result, 0, len(plan))
+		var failed []error
+		for _, action := range plan {
+			if err := manifest.Execute(client, action); err != nil {
+				failed = append(failed, err)
+				results = append(results, output.Result{ID: action.ID, Status: output.ResultFailed, Error: fmt.Sprintf("failed to %s app: %v", action.Type, err)})
+				continue
+			}
+			results = append(results, output.Result{ID: action.ID, Status: appliedStatus[action.Type]})
+		}
+
+		// Print output
+		formatted, err := output.FormatResults(results, outputFormat)
+		if err != nil {
+			return fmt.Errorf("failed to format output: %w", err)
+		}
+		fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+		if len(failed) > 0 {
+			cmd.SilenceUsage = true
+			return clierrors.NewSilent(fmt.Errorf("failed to apply %d of %d apps: %w", len(failed), len(plan), failed[0]))
+		}
+		return nil
+	},
+}
+
+// confirmPrune lists the applications the plan deletes and asks the user to type the
+// organization ID. It returns prompt.ErrAborted if the confirmation does not match.
+func confirmPrune(cmd *cobra.Command, org string, plan []manifest.Action, yes bool) error {
+	var deletions []string
+	for _, action := range plan {
+		if action.Type == manifest.ActionDelete {
+			deletions = append(deletions, action.ID)
+		}
+	}
+	if len(deletions) == 0 {
+		return nil
+	}
+
+	confirmer := prompt.NewConfirmer(cmd.InOrStdin(), cmd.ErrOrStderr(), yes)
+	if err := confirmer.Check("prune applications"); err != nil {
+		return err
+	}
+	if !yes {
+		out := cmd.ErrOrStderr()
+		fmt.Fprintf(out, "The following %d applications have no manifest and will be deleted with all of their environments:\n", len(deletions))
+		for _, id := range deletions {
+			fmt.Fprintf(out, "  %s\n", id)
+		}
+	}
+	confirmed, err := confirmer.ConfirmByTyping(org)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return prompt.ErrAborted
+	}
+	return nil
+}
+
+// Command returns the apply command
+func Command() *cobra.Command {
+	return apply
+}
+
+func init() {
+	// Add common flags
+	apps.CommonFlagSet()(apply)
+
+	// Add command-specific flags
+	apply.Flags().StringP(constants.FilenameFlagName, constants.FilenameFlagShort, "", constants.FilenameFlagHelp)
+	apply.Flags().Bool(constants.PruneFlagName, false, constants.PruneFlagHelp)
+	apply.Flags().BoolP(constants.YesFlagName, constants.YesFlagShort, false, constants.YesFlagHelp)
+	apply.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
+	apply.MarkFlagRequired(constants.FilenameFlagName)
+}
@@ -0,0 +1,166 @@
+package apply
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeManifest writes a manifest file into dir and returns its path
+func writeManifest(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+	return path
+}
+
+// TestApplyCommandExecution verifies that apply creates missing apps, updates changed apps,
+// leaves matching apps untouched and prunes undeclared apps when requested.
+func TestApplyCommandExecution(t *testing.T) {
+	dir := t.TempDir()
+	writeManifest(t, dir, "apps.yaml", "kind: App\nid: test-app\nname: Test App\n---\nkind: App\nid: renamed-app\nname: New Name\n")
+	writeManifest(t, dir, "new.yml", "kind: App\nid: new-app\nname: New App\n")
+	writeManifest(t, dir, "README.md", "not a manifest")
+
+	emptyDir := t.TempDir()
+	writeManifest(t, emptyDir, "empty.yaml", "---\n---\n")
+
+	testCases := []struct {
+		name            string
+		flags           map[string]string
+		expectedOutput  string
+		expectedErr     int // exit code of the expected error, or -1 for any error
+		expectedCreated []string
+		expectedUpdated []string
+		expectedDeleted []string
+	}{
+		{
+			name:  "create, update and no-op",
+			flags: map[string]string{constants.FilenameFlagName: dir},
+			expectedOutput: "ID\tSTATUS\tERROR\n--\t------\t-----\n" +
+				"test-app\tunchanged\t\n" +
+				"renamed-app\tupdated\t\n" +
+				"new-app\tcreated\t\n",
+			expectedCreated: []string{"new-app"},
+			expectedUpdated: []string{"renamed-app"},
+		},
+		{
+			name:  "prune undeclared apps",
+			flags: map[string]string{constants.FilenameFlagName: dir, constants.PruneFlagName: "true", constants.YesFlagName: "true"},
+			expectedOutput: "ID\tSTATUS\tERROR\n--\t------\t-----\n" +
+				"test-app\tunchanged\t\n" +
+				"renamed-app\tupdated\t\n" +
+				"new-app\tcreated\t\n" +
+				"old-app\tdeleted\t\n",
+			expectedCreated: []string{"new-app"},
+			expectedUpdated: []string{"renamed-app"},
+			expectedDeleted: []string{"old-app"},
+		},
+		{
+			name:  "dry run",
+			flags: map[string]string{constants.FilenameFlagName: dir, constants.PruneFlagName: "true", constants.DryRunFlagName: "true", constants.OrgFlagName: "test-org"},
+			expectedOutput: "DRY RUN: PATCH /orgs/test-org/apps/renamed-app\n{\n  \"name\": \"New Name\"\n}\n" +
+				"DRY RUN: POST /orgs/test-org/apps\n{\n  \"id\": \"new-app\",\n  \"name\": \"New App\",\n  \"skip_environment_creation\": false\n}\n" +
+				"DRY RUN: DELETE /orgs/test-org/apps/old-app\n",
+		},
+		{
+			name:        "prune requires confirmation",
+			flags:       map[string]string{constants.FilenameFlagName: dir, constants.PruneFlagName: "true"},
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "prune without manifests",
+			flags:       map[string]string{constants.FilenameFlagName: emptyDir, constants.PruneFlagName: "true", constants.YesFlagName: "true"},
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "missing manifest",
+			flags:       map[string]string{constants.FilenameFlagName: filepath.Join(dir, "missing.yaml")},
+			expectedErr: -1,
+		},
+		{
+			name:        "invalid manifest",
+			flags:       map[string]string{constants.FilenameFlagName: writeManifest(t, t.TempDir(), "bad.yaml", "kind: App\nname: No ID\n")},
+			expectedErr: clierrors.ExitValidation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := &test.MockClient{
+				App: &humanitec.App{ID: "test-app", Name: "Test App"},
+				Apps: []humanitec.App{
+					{ID: "test-app", Name: "Test App"},
+					{ID: "renamed-app", Name: "Old Name"},
+					{ID: "old-app", Name: "Old App"},
+				},
+				AppErrors: map[string]error{"new-app": &humanitec.APIError{StatusCode: 404}},
+				AppsByID:  map[string]*humanitec.App{"renamed-app": {ID: "renamed-app", Name: "Old Name"}},
+			}
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommand(t, apply, apply, []string{}, tc.flags)
+			switch tc.expectedErr {
+			case -1:
+				assert.Error(t, err)
+			default:
+				assert.Equal(t, tc.expectedErr, clierrors.ExitCode(err), "unexpected error: %v", err)
+			}
+			if tc.expectedErr == clierrors.ExitOK {
+				assert.Equal(t, tc.expectedOutput, got)
+			}
+			assert.Equal(t, tc.expectedCreated, mockClient.Created)
+			assert.Equal(t, tc.expectedUpdated, mockClient.Updated)
+			assert.Equal(t, tc.expectedDeleted, mockClient.Deleted)
+		})
+	}
+}
+
+// TestApplyPruneConfirmation verifies that the organization ID must be typed before
+// undeclared apps are deleted.
+func TestApplyPruneConfirmation(t *testing.T) {
+	isInteractive := prompt.IsInteractive
+	prompt.IsInteractive = func(io.Reader) bool { return true }
+	defer func() { prompt.IsInteractive = isInteractive }()
+
+	path := writeManifest(t, t.TempDir(), "apps.yaml", "kind: App\nid: test-app\nname: Test App\n")
+	flags := map[string]string{constants.FilenameFlagName: path, constants.PruneFlagName: "true", constants.OrgFlagName: "test-org"}
+	newMockClient := func() *test.MockClient {
+		return &test.MockClient{
+			App:  &humanitec.App{ID: "test-app", Name: "Test App"},
+			Apps: []humanitec.App{{ID: "test-app", Name: "Test App"}, {ID: "old-app", Name: "Old App"}},
+		}
+	}
+
+	mockClient := newMockClient()
+	test.SetupMockClient(t, mockClient)
+	_, err := test.ExecuteCommandWithInput(t, apply, apply, []string{}, flags, "old-app\n")
+	assert.ErrorIs(t, err, prompt.ErrAborted)
+	assert.Empty(t, mockClient.Deleted)
+
+	mockClient = newMockClient()
+	test.SetupMockClient(t, mockClient)
+	_, err = test.ExecuteCommandWithInput(t, apply, apply, []string{}, flags, "test-org\n")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"old-app"}, mockClient.Deleted)
+}
+
+// TestApplyCommandConfiguration verifies that the apply command is properly configured.
+func TestApplyCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.ApplyCmdUse, apply.Use)
+	assert.Equal(t, constants.ApplyCmdShort, apply.Short)
+	assert.True(t, apply.Flags().Lookup(constants.FilenameFlagName) != nil, "apply command should have filename flag")
+	assert.True(t, apply.Flags().Lookup(constants.PruneFlagName) != nil, "apply command should have prune flag")
+	assert.True(t, apply.Flags().Lookup(constants.YesFlagName) != nil, "apply command should have yes flag")
+	assert.True(t, apply.Flags().Lookup(constants.DryRunFlagName) != nil, "apply command should have dry-run flag")
+}
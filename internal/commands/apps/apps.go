@@ -22,4 +22,29 @@ func UpdateCommand() *cobra.Command {
 // DeleteCommand returns the command for deleting apps
 func DeleteCommand() *cobra.Command {
 	return delete
-} 
\ No newline at end of file
+}
+
+// DescribeCommand returns the command for describing apps
+func DescribeCommand() *cobra.Command {
+	return describe
+}
+
+// CloneCommand returns the command for cloning apps
+func CloneCommand() *cobra.Command {
+	return clone
+}
+
+// ExportCommand returns the command for exporting apps
+func ExportCommand() *cobra.Command {
+	return export
+}
+
+// WaitCommand returns the command for waiting on apps
+func WaitCommand() *cobra.Command {
+	return wait
+}
+
+// MigrateCommand returns the command for migrating apps
+func MigrateCommand() *cobra.Command {
+	return migrate
+}
@@ -0,0 +1,132 @@
+package apps
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/snapshot"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for cloning apps
+	clone = &cobra.Command{
+		Use:   constants.AppCmdUse,
+		Short: constants.AppCmdShort,
+		Long: `Copy an application, its environments and shared values to a new application.
+Secret shared values cannot be read through the API and are skipped. With --include-deployments
+the latest deployment set of each source environment is deployed to the copied environment.
+Progress is reported on standard error. If any step fails, the new application is deleted again.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Get flags
+			from, err := cmd.Flags().GetString(constants.FromFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get from flag: %w", err)
+			}
+
+			id, err := cmd.Flags().GetString(constants.IDFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get id flag: %w", err)
+			}
+
+			name, err := cmd.Flags().GetString(constants.NameFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get name flag: %w", err)
+			}
+
+			includeDeployments, err := cmd.Flags().GetBool(constants.IncludeDeploymentsFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get include-deployments flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Validate input before calling the API
+			if err := validation.ID(validation.App, from); err != nil {
+				return err
+			}
+			if err := validation.ID(validation.App, id); err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(constants.NameFlagName) {
+				if err := validation.Name(name); err != nil {
+					return err
+				}
+			}
+
+			// Get organization ID from the flag or config
+			org, err := OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			snap, err := snapshot.Capture(client, from, snapshot.Options{IncludeSets: includeDeployments})
+			if err != nil {
+				return err
+			}
+
+			// The copy keeps the name of the source app unless a new one is given
+			if name == "" {
+				name = snap.App.Name
+			}
+
+			app, err := snapshot.Restore(client, snap, id, name, PrintProgress(cmd.ErrOrStderr()))
+			if err != nil {
+				return fmt.Errorf("failed to clone app %s: %w", from, err)
+			}
+
+			// Print output
+			formatted, err := output.FormatApp(app, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+// PrintProgress returns a snapshot.Progress that reports every step on w
+func PrintProgress(w io.Writer) snapshot.Progress {
+	return func(step snapshot.Step) {
+		if step.Message != "" {
+			fmt.Fprintf(w, "%s %s %s: %s\n", step.Status, step.Kind, step.ID, step.Message)
+			return
+		}
+		fmt.Fprintf(w, "%s %s %s\n", step.Status, step.Kind, step.ID)
+	}
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(clone)
+
+	// Add command-specific flags
+	clone.Flags().String(constants.FromFlagName, "", constants.FromFlagHelp)
+	clone.Flags().StringP(constants.IDFlagName, constants.IDFlagShort, "", constants.IDFlagHelp)
+	clone.Flags().StringP(constants.NameFlagName, constants.NameFlagShort, "", constants.NameFlagHelp)
+	clone.Flags().Bool(constants.IncludeDeploymentsFlagName, false, constants.IncludeDeploymentsFlagHelp)
+
+	// Mark required flags
+	clone.MarkFlagRequired(constants.FromFlagName)
+	clone.MarkFlagRequired(constants.IDFlagName)
+}
@@ -0,0 +1,142 @@
+package apps
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/diffutil"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCloneAppCommandExecution verifies that the clone app command copies an application
+// and prints the new application.
+func TestCloneAppCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		flags          map[string]string
+		expectedOutput string
+		expectError    bool
+	}{
+		{
+			name: "table format",
+			flags: map[string]string{
+				constants.FromFlagName: "template",
+				constants.IDFlagName:   "new-app",
+				constants.NameFlagName: "New App",
+			},
+			expectedOutput: "NAME\tID\n----\t--\nNew App\tnew-app\n",
+		},
+		{
+			name: "with deployments",
+			flags: map[string]string{
+				constants.FromFlagName:               "template",
+				constants.IDFlagName:                 "new-app",
+				constants.IncludeDeploymentsFlagName: "true",
+				constants.OutputFlagName:             "json",
+			},
+			expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"App\",\n  \"item\": {\n    \"id\": \"new-app\",\n    \"name\": \"New App\"\n  }\n}\n",
+		},
+		{
+			name: "invalid id",
+			flags: map[string]string{
+				constants.FromFlagName: "template",
+				constants.IDFlagName:   "New_App",
+			},
+			expectError: true,
+		},
+		{
+			name:        "missing from flag",
+			flags:       map[string]string{constants.IDFlagName: "new-app"},
+			expectError: true,
+		},
+	}
+
+	test.SetupMockClient(t, test.NewTemplateMockClient())
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(t, clone, clone, []string{}, tt.flags)
+			if (err != nil) != tt.expectError {
+				t.Errorf("clone.Execute() error = %v, wantErr %v", err, tt.expectError)
+				return
+			}
+			if !tt.expectError {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
+
+// TestCloneAppSharedResources verifies that the shared resources of a deployment set are
+// copied along with its workloads.
+func TestCloneAppSharedResources(t *testing.T) {
+	mockClient := test.NewTemplateMockClient()
+	mockClient.Set.Shared = map[string]interface{}{
+		"dns":      map[string]interface{}{"type": "dns"},
+		"db/audit": map[string]interface{}{"type": "postgres"},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	_, err := test.ExecuteCommand(t, clone, clone, []string{}, map[string]string{
+		constants.FromFlagName:               "template",
+		constants.IDFlagName:                 "new-app",
+		constants.IncludeDeploymentsFlagName: "true",
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, mockClient.CreatedDeltas, 1) {
+		delta := mockClient.CreatedDeltas[0]
+		assert.Equal(t, mockClient.Set.Modules, delta.Modules.Add)
+		assert.Equal(t, []interface{}{
+			diffutil.PatchOp{Op: diffutil.OpAdd, Path: "/db~1audit", Value: map[string]interface{}{"type": "postgres"}},
+			diffutil.PatchOp{Op: diffutil.OpAdd, Path: "/dns", Value: map[string]interface{}{"type": "dns"}},
+		}, delta.Shared)
+	}
+}
+
+// TestCloneAppRollback verifies that the new application is deleted again when
+// recreating one of its objects fails.
+func TestCloneAppRollback(t *testing.T) {
+	mockClient := test.NewTemplateMockClient()
+	mockClient.CreateErrors = map[string]error{"REGION": &humanitec.APIError{StatusCode: 409, Message: "value already exists"}}
+	test.SetupMockClient(t, mockClient)
+
+	_, err := test.ExecuteCommand(t, clone, clone, []string{}, map[string]string{
+		constants.FromFlagName: "template",
+		constants.IDFlagName:   "new-app",
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, clierrors.ExitConflict, clierrors.ExitCode(err))
+	assert.Equal(t, []string{"new-app"}, mockClient.Deleted)
+}
+
+// TestCloneAppSourceNotFound verifies that nothing is created when the source application does not exist.
+func TestCloneAppSourceNotFound(t *testing.T) {
+	mockClient := test.NewTemplateMockClient()
+	mockClient.AppErrors = map[string]error{"missing": fmt.Errorf("failed: %w", &humanitec.APIError{StatusCode: 404, Message: "application not found"})}
+	test.SetupMockClient(t, mockClient)
+
+	_, err := test.ExecuteCommand(t, clone, clone, []string{}, map[string]string{
+		constants.FromFlagName: "missing",
+		constants.IDFlagName:   "new-app",
+	})
+
+	assert.Equal(t, clierrors.ExitNotFound, clierrors.ExitCode(err))
+	assert.Empty(t, mockClient.Deleted)
+}
+
+// TestCloneAppCommandConfiguration verifies that the clone app command is properly configured
+// with the correct name, description, and flags.
+func TestCloneAppCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.AppCmdUse, clone.Name(), "clone command should have correct use")
+	assert.Equal(t, constants.AppCmdShort, clone.Short, "clone command should have correct short description")
+
+	assert.True(t, clone.Flags().Lookup(constants.FromFlagName) != nil, "clone command should have from flag")
+	assert.True(t, clone.Flags().Lookup(constants.IDFlagName) != nil, "clone command should have id flag")
+	assert.True(t, clone.Flags().Lookup(constants.IncludeDeploymentsFlagName) != nil, "clone command should have include-deployments flag")
+}
@@ -1,7 +1,14 @@
 package apps
 
 import (
+	"fmt"
+	"sync"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
 	"github.com/spf13/cobra"
 )
 
@@ -11,4 +18,99 @@ func CommonFlagSet() func(*cobra.Command) {
 		cmd.Flags().StringP(constants.OutputFlagName, constants.OutputFlagShort, constants.DefaultOutputFormat, constants.OutputFlagHelp)
 		cmd.Flags().StringP(constants.OrgFlagName, constants.OrgFlagShort, "", constants.OrgFlagHelp)
 	}
-} 
\ No newline at end of file
+}
+
+// OrgID returns the organization given with --org, or the organization from the config file
+func OrgID(cmd *cobra.Command) (string, error) {
+	org, err := cmd.Flags().GetString(constants.OrgFlagName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get org flag: %w", err)
+	}
+	if org == "" {
+		org = config.GetConfig().HumanitecOrg
+	}
+	return org, nil
+}
+
+// maxConcurrency is the maximum number of API calls made in parallel for multi-target operations
+const maxConcurrency = 8
+
+// appIDs returns the application IDs given through the --id flag and as positional arguments,
+// in order and without duplicates
+func appIDs(cmd *cobra.Command, args []string) ([]string, error) {
+	id, err := cmd.Flags().GetString(constants.IDFlagName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get id flag: %w", err)
+	}
+
+	var ids []string
+	seen := map[string]bool{}
+	for _, candidate := range append([]string{id}, args...) {
+		if candidate == "" || seen[candidate] {
+			continue
+		}
+		seen[candidate] = true
+		ids = append(ids, candidate)
+	}
+	return ids, nil
+}
+
+// forEachID runs fn for every ID concurrently, passing the ID and its index, and returns
+// a result per ID in input order. A failure for one ID does not stop the others.
+func forEachID(ids []string, fn func(i int, id string) error) ([]output.Result, []error) {
+	results := make([]output.Result, len(ids))
+	errs := make([]error, len(ids))
+	sem := make(chan struct{}, maxConcurrency)
+
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		i, id := i, id
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			errs[i] = fn(i, id)
+			results[i] = output.Result{ID: id, Status: output.ResultSucceeded}
+			if errs[i] != nil {
+				results[i].Status = output.ResultFailed
+				results[i].Error = errs[i].Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results, errs
+}
+
+// summaryError returns an error describing how many of the operations failed,
+// or nil if they all succeeded. The failures are already part of the printed
+// summary, so neither the error nor the usage is printed again.
+func summaryError(cmd *cobra.Command, action string, errs []error) error {
+	failed := 0
+	var first error
+	for _, err := range errs {
+		if err != nil {
+			failed++
+			if first == nil {
+				first = err
+			}
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+	cmd.SilenceUsage = true
+	return clierrors.NewSilent(fmt.Errorf("failed to %s %d of %d apps: %w", action, failed, len(errs), first))
+}
+
+// PrintRequests prints the requests a dry run would have sent
+func PrintRequests(cmd *cobra.Command, requests []humanitec.Request, outputFormat output.Format) error {
+	formatted, err := output.FormatRequests(requests, outputFormat)
+	if err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+	fmt.Fprint(cmd.OutOrStdout(), formatted)
+	return nil
+}
@@ -0,0 +1,47 @@
+package apps
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestOrgID verifies that the organization given with --org takes precedence over the
+// organization from the config file
+func TestOrgID(t *testing.T) {
+	defer config.SetConfig(config.GetConfig())
+	config.SetConfig(config.Config{HumanitecToken: "test-token", HumanitecOrg: "config-org"})
+
+	testCases := []struct {
+		name     string
+		org      string
+		expected string
+	}{
+		{
+			name:     "from config",
+			expected: "config-org",
+		},
+		{
+			name:     "from flag",
+			org:      "flag-org",
+			expected: "flag-org",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			CommonFlagSet()(cmd)
+			if tc.org != "" {
+				assert.NoError(t, cmd.Flags().Set(constants.OrgFlagName, tc.org))
+			}
+
+			org, err := OrgID(cmd)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, org)
+		})
+	}
+}
@@ -3,10 +3,12 @@ package apps
 import (
 	"fmt"
 
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
 	"github.com/spf13/cobra"
 )
 
@@ -17,7 +19,9 @@ var (
 		Short: constants.AppCmdShort,
 		Long: `Create a new application in the organization.
 The application requires both a name (human-friendly display name) and an ID (unique identifier).
-The ID must match the pattern: ^[a-z0-9](?:-?[a-z0-9]+)+$`,
+The ID must match the pattern: ^[a-z0-9](?:-?[a-z0-9]+)+$ and be 50 characters or less.
+With --generate-id the ID is derived from the name, e.g. "My App" becomes "my-app".
+With --dry-run the request is validated and printed instead of being sent.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Get flags
 			name, err := cmd.Flags().GetString(constants.NameFlagName)
@@ -46,10 +50,44 @@ The ID must match the pattern: ^[a-z0-9](?:-?[a-z0-9]+)+$`,
 				return fmt.Errorf("failed to get skip environment creation flag: %w", err)
 			}
 
-			// Get organization ID from config
-			org := config.GetConfig().HumanitecOrg
+			generateID, err := cmd.Flags().GetBool(constants.GenerateIDFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get generate id flag: %w", err)
+			}
+
+			dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
+
+			// Validate input before calling the API
+			if err := validation.Name(name); err != nil {
+				return err
+			}
+			if id == "" && generateID {
+				if id = validation.Slugify(validation.App, name); id == "" {
+					return clierrors.Validationf(constants.ErrGenerateID, name)
+				}
+			}
+			if id == "" {
+				return clierrors.Validationf("required flag(s) \"%s\" not set", constants.IDFlagName)
+			}
+			if err := validation.ID(validation.App, id); err != nil {
+				return err
+			}
+
+			// Get organization ID from the flag or config
+			org, err := OrgID(cmd)
+			if err != nil {
+				return err
+			}
 			token := config.GetConfig().HumanitecToken
 
+			// Print the request instead of sending it on a dry run
+			if dryRun {
+				return PrintRequests(cmd, []humanitec.Request{humanitec.CreateAppRequest(org, id, name, skipEnvCreation)}, outputFormat)
+			}
+
 			// Create Humanitec client
 			client := humanitec.NewClient(token, org)
 
@@ -79,6 +117,7 @@ func init() {
 	create.Flags().StringP(constants.NameFlagName, constants.NameFlagShort, "", constants.NameFlagHelp)
 	create.Flags().StringP(constants.IDFlagName, constants.IDFlagShort, "", constants.IDFlagHelp)
 	create.Flags().BoolP(constants.SkipEnvCreationFlagName, constants.SkipEnvCreationFlagShort, false, constants.SkipEnvCreationFlagHelp)
+	create.Flags().Bool(constants.GenerateIDFlagName, false, constants.GenerateIDFlagHelp)
+	create.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
 	create.MarkFlagRequired(constants.NameFlagName)
-	create.MarkFlagRequired(constants.IDFlagName)
 } 
\ No newline at end of file
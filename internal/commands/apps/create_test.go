@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
@@ -29,14 +30,14 @@ var createTestCases = []struct {
 		name:           "create app with valid id and name - json format",
 		args:           []string{"create"},
 		flags:          map[string]string{constants.IDFlagName: "test-app", constants.NameFlagName: "Test App", "output": "json"},
-		expectedOutput: "{\n  \"id\": \"test-app\",\n  \"name\": \"Test App\"\n}\n",
+		expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"App\",\n  \"item\": {\n    \"id\": \"test-app\",\n    \"name\": \"Test App\"\n  }\n}\n",
 		expectError:    false,
 	},
 	{
 		name:           "create app with valid id and name - yaml format",
 		args:           []string{"create"},
 		flags:          map[string]string{constants.IDFlagName: "test-app", constants.NameFlagName: "Test App", "output": "yaml"},
-		expectedOutput: "id: test-app\nname: Test App\n",
+		expectedOutput: "apiVersion: humctl-wrapper/v1\nkind: App\nitem:\n    id: test-app\n    name: Test App\n",
 		expectError:    false,
 	},
 	{
@@ -60,11 +61,39 @@ var createTestCases = []struct {
 		expectedOutput: "required flag(s) \"name\" not set",
 		expectError:    true,
 	},
+	{
+		name:           "invalid id format",
+		args:           []string{"create"},
+		flags:          map[string]string{constants.IDFlagName: "My_App", constants.NameFlagName: "Test App", "output": "table"},
+		expectedOutput: "invalid application id \"My_App\": must match pattern ^[a-z0-9](?:-?[a-z0-9]+)+$ (did you mean \"my-app\"?)",
+		expectError:    true,
+	},
+	{
+		name:           "id too long",
+		args:           []string{"create"},
+		flags:          map[string]string{constants.IDFlagName: "a-very-long-application-identifier-that-exceeds-fifty", constants.NameFlagName: "Test App", "output": "table"},
+		expectedOutput: "invalid application id \"a-very-long-application-identifier-that-exceeds-fifty\": must be 50 characters or less (did you mean \"a-very-long-application-identifier-that-exceeds-fi\"?)",
+		expectError:    true,
+	},
+	{
+		name:           "generate id from name",
+		args:           []string{"create"},
+		flags:          map[string]string{constants.NameFlagName: "Test App", constants.GenerateIDFlagName: "true", "output": "table"},
+		expectedOutput: "NAME\tID\n----\t--\nTest App\ttest-app\n",
+		expectError:    false,
+	},
+	{
+		name:           "generate id from name without valid characters",
+		args:           []string{"create"},
+		flags:          map[string]string{constants.NameFlagName: "!!!", constants.GenerateIDFlagName: "true", "output": "table"},
+		expectedOutput: "cannot generate an id from name \"!!!\", please provide one with --id",
+		expectError:    true,
+	},
 	{
 		name:           "invalid output format",
 		args:           []string{"create"},
 		flags:          map[string]string{constants.IDFlagName: "test-app", constants.NameFlagName: "Test App", "output": "invalid"},
-		expectedOutput: "invalid output format: unsupported output format: invalid. Supported formats: table, json, yaml",
+		expectedOutput: "invalid output format: unsupported output format: invalid. Supported formats: table, wide, json, yaml, ndjson",
 		expectError:    true,
 	},
 	{
@@ -132,4 +161,26 @@ func TestCreateAppCommandConfiguration(t *testing.T) {
 	assert.True(t, create.Flags().Lookup(constants.NameFlagName) != nil, "create command should have name flag")
 	assert.True(t, create.Flags().Lookup(constants.OutputFlagName) != nil, "create command should have output flag")
 	assert.True(t, create.Flags().Lookup(constants.SkipEnvCreationFlagName) != nil, "create command should have skip-env-creation flag")
-} 
\ No newline at end of file
+}
+
+// TestCreateAppDryRun verifies that --dry-run validates the input and prints the request
+// body instead of creating the application.
+func TestCreateAppDryRun(t *testing.T) {
+	test.SetupMockClient(t, &test.MockClient{Error: fmt.Errorf("API should not be called")})
+
+	got, err := test.ExecuteCommand(t, create, create, []string{}, map[string]string{
+		constants.NameFlagName:       "My App",
+		constants.OrgFlagName:        "test-org",
+		constants.GenerateIDFlagName: "true",
+		constants.DryRunFlagName:     "true",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "DRY RUN: POST /orgs/test-org/apps\n{\n  \"id\": \"my-app\",\n  \"name\": \"My App\",\n  \"skip_environment_creation\": false\n}\n", got)
+
+	_, err = test.ExecuteCommand(t, create, create, []string{}, map[string]string{
+		constants.NameFlagName:   "My App",
+		constants.IDFlagName:     "Invalid_ID",
+		constants.DryRunFlagName: "true",
+	})
+	assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(err))
+}
@@ -2,22 +2,37 @@ package apps
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
 	"github.com/spf13/cobra"
 )
 
 var delete = &cobra.Command{
-	Use:   constants.AppCmdUse,
-	Short: constants.AppCmdShort,
+	Use:     constants.AppCmdUse + " [id...]",
+	Aliases: []string{constants.AppsCmdUse},
+	Short:   constants.AppCmdShort,
+	Long: `Delete one or more applications from the organization.
+Application IDs can be given with --id and as positional arguments. Several applications
+are deleted concurrently and a per-application summary is printed.
+
+Before deleting, the application and its environments are shown and the application ID
+must be typed to confirm. Use --yes to skip the confirmation in automation, and --dry-run
+to print the requests without sending them.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		// Get flags
-		id, err := cmd.Flags().GetString(constants.IDFlagName)
+		// Get application IDs from the --id flag and positional arguments
+		ids, err := appIDs(cmd, args)
 		if err != nil {
-			return fmt.Errorf("failed to get id flag: %w", err)
+			return err
+		}
+		if len(ids) == 0 {
+			return clierrors.Validationf("at least one application ID is required")
 		}
 
 		outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
@@ -31,20 +46,88 @@ var delete = &cobra.Command{
 			return fmt.Errorf("invalid output format: %w", err)
 		}
 
-		// Get organization ID from config
-		org := config.GetConfig().HumanitecOrg
+		yes, err := cmd.Flags().GetBool(constants.YesFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get yes flag: %w", err)
+		}
+
+		dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get dry-run flag: %w", err)
+		}
+
+		for _, id := range ids {
+			if err := validation.ID(validation.App, id); err != nil {
+				return err
+			}
+		}
+
+		// Get organization ID from the flag or config
+		org, err := OrgID(cmd)
+		if err != nil {
+			return err
+		}
 		token := config.GetConfig().HumanitecToken
 
 		// Create Humanitec client
 		client := humanitec.NewClient(token, org)
 
+		// Print the requests instead of sending them on a dry run
+		if dryRun {
+			requests := make([]humanitec.Request, 0, len(ids))
+			for _, id := range ids {
+				requests = append(requests, humanitec.DeleteAppRequest(org, id))
+			}
+			return PrintRequests(cmd, requests, outputFormat)
+		}
+
+		// Show what will be deleted and ask for confirmation
+		confirmer := prompt.NewConfirmer(cmd.InOrStdin(), cmd.ErrOrStderr(), yes)
+		if err := confirmer.Check("delete applications"); err != nil {
+			return err
+		}
+		confirmed, failed, err := confirmDeletion(cmd, client, confirmer, ids, yes)
+		if err != nil {
+			return err
+		}
+
+		// Delete several apps concurrently and report the outcome of each
+		if len(ids) > 1 {
+			results, errs := forEachID(ids, func(i int, id string) error {
+				if err := failed[id]; err != nil {
+					return err
+				}
+				if !confirmed[id] {
+					return nil
+				}
+				return client.DeleteApp(id)
+			})
+			for i, id := range ids {
+				if failed[id] == nil && !confirmed[id] {
+					results[i].Status = output.ResultSkipped
+				}
+			}
+			formatted, err := output.FormatResults(results, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+			return summaryError(cmd, "delete", errs)
+		}
+
 		// Delete app
-		if err := client.DeleteApp(id); err != nil {
+		if err := failed[ids[0]]; err != nil {
+			return err
+		}
+		if !confirmed[ids[0]] {
+			return prompt.ErrAborted
+		}
+		if err := client.DeleteApp(ids[0]); err != nil {
 			return fmt.Errorf("failed to delete app: %w", err)
 		}
 
 		// Print output
-		formatted, err := output.FormatMessage("Application successfully deleted", outputFormat)
+		formatted, err := output.FormatSuccess("Application successfully deleted", outputFormat)
 		if err != nil {
 			return fmt.Errorf("failed to format output: %w", err)
 		}
@@ -54,11 +137,58 @@ var delete = &cobra.Command{
 	},
 }
 
+// confirmDeletion shows each application with its environments and asks the user to
+// type its ID. It returns the set of confirmed IDs and the errors of applications that
+// could not be shown, which are not deleted; with --yes every ID is confirmed.
+func confirmDeletion(cmd *cobra.Command, client humanitec.Client, confirmer *prompt.Confirmer, ids []string, yes bool) (map[string]bool, map[string]error, error) {
+	confirmed := make(map[string]bool, len(ids))
+	failed := map[string]error{}
+	for _, id := range ids {
+		if yes {
+			confirmed[id] = true
+			continue
+		}
+
+		app, err := client.GetApp(id)
+		if err != nil {
+			failed[id] = fmt.Errorf("failed to get app %s: %w", id, err)
+			continue
+		}
+		envs, err := client.GetEnvs(id)
+		if err != nil {
+			failed[id] = fmt.Errorf("failed to get environments of app %s: %w", id, err)
+			continue
+		}
+
+		envIDs := make([]string, 0, len(envs))
+		for _, env := range envs {
+			envIDs = append(envIDs, env.ID)
+		}
+		if len(envIDs) == 0 {
+			envIDs = append(envIDs, "<none>")
+		}
+
+		out := cmd.ErrOrStderr()
+		fmt.Fprintln(out, "The following application and all of its environments will be deleted:")
+		fmt.Fprintf(out, "  ID:           %s\n", app.ID)
+		fmt.Fprintf(out, "  Name:         %s\n", app.Name)
+		fmt.Fprintf(out, "  Environments: %s\n", strings.Join(envIDs, ", "))
+
+		ok, err := confirmer.ConfirmByTyping(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		confirmed[id] = ok
+	}
+	return confirmed, failed, nil
+}
+
 func init() {
 	// Add common flags
 	CommonFlagSet()(delete)
-	
+
 	// Add command-specific flags
 	delete.Flags().StringP(constants.IDFlagName, constants.IDFlagShort, "", constants.IDFlagHelp)
-	delete.MarkFlagRequired(constants.IDFlagName)
+	delete.Flags().BoolP(constants.YesFlagName, constants.YesFlagShort, false, constants.YesFlagHelp)
+	delete.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
 } 
\ No newline at end of file
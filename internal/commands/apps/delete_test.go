@@ -2,10 +2,13 @@ package apps
 
 import (
 	"fmt"
+	"io"
 	"testing"
 
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,10 +23,11 @@ var deleteTestCases = []struct {
 }{
 	{
 		name:  "table format",
-		args:  []string{"delete"},
+		args:  []string{},
 		flags: map[string]string{
 			constants.IDFlagName:    "test-app",
 			constants.OrgFlagName:   "test-org",
+			constants.YesFlagName:   "true",
 			constants.OutputFlagName: constants.DefaultOutputFormat,
 		},
 		expectedOutput: "Application successfully deleted\n",
@@ -31,48 +35,52 @@ var deleteTestCases = []struct {
 	},
 	{
 		name:  "json format",
-		args:  []string{"delete"},
+		args:  []string{},
 		flags: map[string]string{
 			constants.IDFlagName:    "test-app",
 			constants.OrgFlagName:   "test-org",
+			constants.YesFlagName:   "true",
 			constants.OutputFlagName: "json",
 		},
-		expectedOutput: "{\n  \"message\": \"Application successfully deleted\"\n}\n",
+		expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"Message\",\n  \"item\": {\n    \"message\": \"Application successfully deleted\"\n  }\n}\n",
 		expectError:    false,
 	},
 	{
 		name:  "yaml format",
-		args:  []string{"delete"},
+		args:  []string{},
 		flags: map[string]string{
 			constants.IDFlagName:    "test-app",
 			constants.OrgFlagName:   "test-org",
+			constants.YesFlagName:   "true",
 			constants.OutputFlagName: "yaml",
 		},
-		expectedOutput: "message: Application successfully deleted\n",
+		expectedOutput: "apiVersion: humctl-wrapper/v1\nkind: Message\nitem:\n    message: Application successfully deleted\n",
 		expectError:    false,
 	},
 	{
 		name:        "missing id flag",
-		args:        []string{"delete"},
+		args:        []string{},
 		flags:       map[string]string{},
 		expectError: true,
 	},
 	{
 		name:  "invalid output format",
-		args:  []string{"delete"},
+		args:  []string{},
 		flags: map[string]string{
 			constants.IDFlagName:    "test-app",
 			constants.OrgFlagName:   "test-org",
+			constants.YesFlagName:   "true",
 			constants.OutputFlagName: "invalid",
 		},
 		expectError: true,
 	},
 	{
 		name:  "api error",
-		args:  []string{"delete"},
+		args:  []string{},
 		flags: map[string]string{
 			constants.IDFlagName:  "test-app",
 			constants.OrgFlagName: "test-org",
+			constants.YesFlagName: "true",
 		},
 		expectError: true,
 		mockError:   fmt.Errorf("API error"),
@@ -114,11 +122,129 @@ func TestDeleteAppCommandExecution(t *testing.T) {
 // with the correct name, description, and required flags.
 func TestDeleteAppCommandConfiguration(t *testing.T) {
 	// Test delete command
-	assert.Equal(t, constants.AppCmdUse, delete.Use, "delete command should have correct use")
+	assert.Equal(t, constants.AppCmdUse, delete.Name(), "delete command should have correct use")
 	assert.Equal(t, constants.AppCmdShort, delete.Short, "delete command should have correct short description")
 
 	// Test flags
 	assert.True(t, delete.Flags().HasFlags(), "delete command should have flags")
 	assert.True(t, delete.Flags().Lookup(constants.IDFlagName) != nil, "delete command should have id flag")
 	assert.True(t, delete.Flags().Lookup(constants.OutputFlagName) != nil, "delete command should have output flag")
-} 
\ No newline at end of file
+} 
+// TestDeleteAppMultipleIDs verifies that several applications given as positional arguments
+// are all deleted and that a failure for one of them is reported without aborting the others.
+func TestDeleteAppMultipleIDs(t *testing.T) {
+	mockClient := &test.MockClient{
+		AppErrors: map[string]error{"missing-app": &humanitec.APIError{StatusCode: 404, Message: "application not found"}},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	got, err := test.ExecuteCommand(t, delete, delete, []string{"app-b", "missing-app"}, map[string]string{
+		constants.IDFlagName:     "app-a",
+		constants.YesFlagName:    "true",
+		constants.OutputFlagName: "table",
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, clierrors.ExitNotFound, clierrors.ExitCode(err))
+	assert.True(t, clierrors.IsSilent(err), "failures are part of the summary and should not be printed again")
+	assert.Equal(t, "ID\tSTATUS\tERROR\n--\t------\t-----\n"+
+		"app-a\tsucceeded\t\n"+
+		"app-b\tsucceeded\t\n"+
+		"missing-app\tfailed\tAPI request failed with status 404: application not found\n", got)
+}
+
+// TestDeleteAppRequiresConfirmation verifies that nothing is deleted when input is not
+// a terminal and --yes is not given.
+func TestDeleteAppRequiresConfirmation(t *testing.T) {
+	test.SetupMockClient(t, &test.MockClient{App: &humanitec.App{ID: "test-app", Name: "Test App"}})
+
+	got, err := test.ExecuteCommand(t, delete, delete, []string{}, map[string]string{
+		constants.IDFlagName: "test-app",
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(err))
+	assert.NotContains(t, got, "Application successfully deleted")
+}
+
+// TestDeleteAppConfirmation verifies that the application ID must be typed to confirm a
+// deletion and that declined applications are skipped when several are deleted.
+func TestDeleteAppConfirmation(t *testing.T) {
+	isInteractive := prompt.IsInteractive
+	prompt.IsInteractive = func(io.Reader) bool { return true }
+	defer func() { prompt.IsInteractive = isInteractive }()
+
+	test.SetupMockClient(t, &test.MockClient{
+		AppsByID: map[string]*humanitec.App{
+			"app-a": {ID: "app-a", Name: "App A"},
+			"app-b": {ID: "app-b", Name: "App B"},
+		},
+		Envs: []humanitec.Environment{{ID: "development"}},
+	})
+
+	got, err := test.ExecuteCommandWithInput(t, delete, delete, []string{"app-a"}, map[string]string{}, "app-a\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "Application successfully deleted\n", got)
+
+	_, err = test.ExecuteCommandWithInput(t, delete, delete, []string{"app-a"}, map[string]string{}, "yes\n")
+	assert.ErrorIs(t, err, prompt.ErrAborted)
+
+	got, err = test.ExecuteCommandWithInput(t, delete, delete, []string{"app-a", "app-b"}, map[string]string{}, "app-a\nno\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "ID\tSTATUS\tERROR\n--\t------\t-----\n"+
+		"app-a\tsucceeded\t\n"+
+		"app-b\tskipped\t\n", got)
+}
+
+// TestDeleteAppConfirmationLookupFailure verifies that an application that cannot be shown
+// for confirmation is reported as failed while the others are still confirmed and deleted.
+func TestDeleteAppConfirmationLookupFailure(t *testing.T) {
+	isInteractive := prompt.IsInteractive
+	prompt.IsInteractive = func(io.Reader) bool { return true }
+	defer func() { prompt.IsInteractive = isInteractive }()
+
+	mockClient := &test.MockClient{
+		AppsByID: map[string]*humanitec.App{
+			"app-a": {ID: "app-a", Name: "App A"},
+			"app-b": {ID: "app-b", Name: "App B"},
+		},
+		AppErrors: map[string]error{"missing-app": &humanitec.APIError{StatusCode: 404, Message: "application not found"}},
+		Envs:      []humanitec.Environment{{ID: "development"}},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	got, err := test.ExecuteCommandWithInput(t, delete, delete, []string{"app-a", "missing-app", "app-b"}, map[string]string{}, "app-a\napp-b\n")
+	assert.Error(t, err)
+	assert.Equal(t, clierrors.ExitNotFound, clierrors.ExitCode(err))
+	assert.Equal(t, "ID\tSTATUS\tERROR\n--\t------\t-----\n"+
+		"app-a\tsucceeded\t\n"+
+		"missing-app\tfailed\tfailed to get app missing-app: API request failed with status 404: application not found\n"+
+		"app-b\tsucceeded\t\n", got)
+	assert.ElementsMatch(t, []string{"app-a", "app-b"}, mockClient.Deleted)
+
+	_, err = test.ExecuteCommandWithInput(t, delete, delete, []string{"missing-app"}, map[string]string{}, "")
+	assert.EqualError(t, err, "failed to get app missing-app: API request failed with status 404: application not found")
+}
+
+// TestDeleteAppDryRun verifies that --dry-run prints the requests without confirmation
+// and without calling the API.
+func TestDeleteAppDryRun(t *testing.T) {
+	test.SetupMockClient(t, &test.MockClient{Error: fmt.Errorf("API should not be called")})
+
+	got, err := test.ExecuteCommand(t, delete, delete, []string{"app-b"}, map[string]string{
+		constants.IDFlagName:     "app-a",
+		constants.OrgFlagName:    "test-org",
+		constants.DryRunFlagName: "true",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "DRY RUN: DELETE /orgs/test-org/apps/app-a\nDRY RUN: DELETE /orgs/test-org/apps/app-b\n", got)
+
+	got, err = test.ExecuteCommand(t, delete, delete, []string{}, map[string]string{
+		constants.IDFlagName:     "app-a",
+		constants.OrgFlagName:    "test-org",
+		constants.DryRunFlagName: "true",
+		constants.OutputFlagName: "json",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"DryRun\",\n  \"items\": [\n    {\n      \"method\": \"DELETE\",\n      \"path\": \"/orgs/test-org/apps/app-a\"\n    }\n  ]\n}\n", got)
+}
@@ -0,0 +1,133 @@
+package apps
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for describing an app
+	describe = &cobra.Command{
+		Use:   constants.AppCmdUse + " <id>",
+		Short: constants.AppCmdShort,
+		Long: `Show a detailed summary of an application.
+The application, its environments with their latest deployment, the number of shared values
+and the attached pipelines are fetched in parallel and combined into a single view.
+If the environments, shared values or pipelines cannot be fetched, the application is still
+shown: the failed sections are marked as unavailable, listed under "errors" in machine-readable
+output, and reported as warnings on stderr.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			description, err := describeApp(client, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to describe app: %w", err)
+			}
+
+			for _, failure := range description.Errors {
+				cmd.PrintErrln(output.ErrorText(cmd.ErrOrStderr(), fmt.Sprintf("Warning: failed to get %s of app %s: %s", sectionNames[failure.ID], args[0], failure.Message)))
+			}
+
+			// Print output
+			formatted, err := output.FormatAppDescription(description, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+// sectionNames are the names of the sections of an application description in warnings
+var sectionNames = map[string]string{
+	output.SectionEnvironments: "environments",
+	output.SectionSharedValues: "shared values",
+	output.SectionPipelines:    "pipelines",
+}
+
+// describeApp fetches an application and its related resources in parallel. Only a failure to
+// fetch the application is returned; failures of the other resources are recorded in the
+// description so that the sections that succeeded can still be shown.
+func describeApp(client humanitec.Client, id string) (*output.AppDescription, error) {
+	var (
+		wg          sync.WaitGroup
+		description output.AppDescription
+		appErr      error
+		envsErr     error
+		valuesErr   error
+		pipelineErr error
+	)
+
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		description.App, appErr = client.GetApp(id)
+	}()
+	go func() {
+		defer wg.Done()
+		description.Environments, envsErr = client.GetEnvs(id)
+	}()
+	go func() {
+		defer wg.Done()
+		var values []humanitec.Value
+		values, valuesErr = client.GetValues(id)
+		description.SharedValues = len(values)
+	}()
+	go func() {
+		defer wg.Done()
+		description.Pipelines, pipelineErr = client.GetPipelines(id)
+	}()
+	wg.Wait()
+
+	// The app itself is required, so report its error on its own
+	if appErr != nil {
+		return nil, appErr
+	}
+	for _, section := range []struct {
+		id  string
+		err error
+	}{
+		{output.SectionEnvironments, envsErr},
+		{output.SectionSharedValues, valuesErr},
+		{output.SectionPipelines, pipelineErr},
+	} {
+		if section.err != nil {
+			description.Errors = append(description.Errors, output.NewErrorDetail(section.id, section.err))
+		}
+	}
+
+	return &description, nil
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(describe)
+}
@@ -0,0 +1,153 @@
+package apps
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+var describeTestCases = []struct {
+	name           string
+	args           []string
+	flags          map[string]string
+	expectedOutput string
+	expectError    bool
+	mockError      error
+	valuesError    error
+	pipelinesError error
+}{
+	{
+		name:  "table format",
+		args:  []string{"test-app"},
+		flags: map[string]string{constants.OutputFlagName: "table"},
+		expectedOutput: "Name:           Test App\n" +
+			"ID:             test-app\n" +
+			"Created At:     2024-01-02T03:04:05Z\n" +
+			"Created By:     user-1\n" +
+			"Shared Values:  2\n" +
+			"\n" +
+			"Environments:\n" +
+			"  ID           NAME         TYPE         LAST DEPLOYMENT  STATUS     DEPLOYED AT\n" +
+			"  development  Development  development  deploy-1         succeeded  2024-01-03T00:00:00Z\n" +
+			"  production   Production   production   <none>           <none>     <none>\n" +
+			"\n" +
+			"Pipelines:\n" +
+			"  ID      NAME    STATUS\n" +
+			"  deploy  Deploy  active\n",
+	},
+	{
+		name:           "json format",
+		args:           []string{"test-app"},
+		flags:          map[string]string{constants.OutputFlagName: "json"},
+		expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"AppDescription\",\n  \"item\": {\n    \"app\": {\n      \"id\": \"test-app\",\n      \"name\": \"Test App\",\n      \"created_at\": \"2024-01-02T03:04:05Z\",\n      \"created_by\": \"user-1\"\n    },\n    \"environments\": [\n      {\n        \"id\": \"development\",\n        \"name\": \"Development\",\n        \"type\": \"development\",\n        \"last_deploy\": {\n          \"id\": \"deploy-1\",\n          \"env_id\": \"development\",\n          \"set_id\": \"set-1\",\n          \"status\": \"succeeded\",\n          \"created_at\": \"2024-01-03T00:00:00Z\"\n        }\n      },\n      {\n        \"id\": \"production\",\n        \"name\": \"Production\",\n        \"type\": \"production\"\n      }\n    ],\n    \"sharedValues\": 2,\n    \"pipelines\": [\n      {\n        \"id\": \"deploy\",\n        \"name\": \"Deploy\",\n        \"app_id\": \"test-app\",\n        \"status\": \"active\"\n      }\n    ]\n  }\n}\n",
+	},
+	{
+		name:           "failed sections in table format",
+		args:           []string{"test-app"},
+		flags:          map[string]string{constants.OutputFlagName: "table"},
+		valuesError:    fmt.Errorf("values API error"),
+		pipelinesError: fmt.Errorf("pipelines API error"),
+		expectedOutput: "Name:           Test App\n" +
+			"ID:             test-app\n" +
+			"Created At:     2024-01-02T03:04:05Z\n" +
+			"Created By:     user-1\n" +
+			"Shared Values:  <unavailable>\n" +
+			"\n" +
+			"Environments:\n" +
+			"  ID           NAME         TYPE         LAST DEPLOYMENT  STATUS     DEPLOYED AT\n" +
+			"  development  Development  development  deploy-1         succeeded  2024-01-03T00:00:00Z\n" +
+			"  production   Production   production   <none>           <none>     <none>\n" +
+			"\n" +
+			"Pipelines:\n" +
+			"  <unavailable>\n",
+	},
+	{
+		name:           "failed section in ndjson format",
+		args:           []string{"test-app"},
+		flags:          map[string]string{constants.OutputFlagName: "ndjson"},
+		pipelinesError: fmt.Errorf("pipelines API error"),
+		expectedOutput: `{"app":{"id":"test-app","name":"Test App","created_at":"2024-01-02T03:04:05Z","created_by":"user-1"},"environments":[{"id":"development","name":"Development","type":"development","last_deploy":{"id":"deploy-1","env_id":"development","set_id":"set-1","status":"succeeded","created_at":"2024-01-03T00:00:00Z"}},{"id":"production","name":"Production","type":"production"}],"sharedValues":2,"pipelines":null,"errors":[{"id":"pipelines","code":"Internal","message":"pipelines API error"}]}` + "\n",
+	},
+	{
+		name:        "missing id argument",
+		args:        []string{},
+		flags:       map[string]string{},
+		expectError: true,
+	},
+	{
+		name:        "api error",
+		args:        []string{"test-app"},
+		flags:       map[string]string{},
+		expectError: true,
+		mockError:   fmt.Errorf("API error"),
+	},
+}
+
+// TestDescribeAppCommandExecution verifies the describe app command's runtime behavior by testing
+// the aggregated view in different output formats and error conditions using a mock client.
+func TestDescribeAppCommandExecution(t *testing.T) {
+	// Create a mock client
+	mockClient := &test.MockClient{
+		App: &humanitec.App{
+			ID:        "test-app",
+			Name:      "Test App",
+			CreatedAt: "2024-01-02T03:04:05Z",
+			CreatedBy: "user-1",
+		},
+		Envs: []humanitec.Environment{
+			{
+				ID:   "development",
+				Name: "Development",
+				Type: "development",
+				LastDeploy: &humanitec.Deployment{
+					ID:        "deploy-1",
+					EnvID:     "development",
+					SetID:     "set-1",
+					Status:    "succeeded",
+					CreatedAt: "2024-01-03T00:00:00Z",
+				},
+			},
+			{ID: "production", Name: "Production", Type: "production"},
+		},
+		Values: []humanitec.Value{
+			{Key: "DB_HOST", Value: "db"},
+			{Key: "API_KEY", IsSecret: true},
+		},
+		Pipelines: []humanitec.Pipeline{
+			{ID: "deploy", Name: "Deploy", AppID: "test-app", Status: "active"},
+		},
+	}
+
+	// Set up the mock client
+	test.SetupMockClient(t, mockClient)
+
+	for _, tt := range describeTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Set mock error if specified
+			mockClient.Error = tt.mockError
+			mockClient.ValuesError = tt.valuesError
+			mockClient.PipelinesError = tt.pipelinesError
+
+			got, err := test.ExecuteCommand(t, describe, describe, tt.args, tt.flags)
+			if (err != nil) != tt.expectError {
+				t.Errorf("describe.Execute() error = %v, wantErr %v", err, tt.expectError)
+				return
+			}
+			if !tt.expectError {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
+
+// TestDescribeAppCommandConfiguration verifies that the describe app command is properly configured
+// with the correct name, description, and flags.
+func TestDescribeAppCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.AppCmdUse, describe.Name(), "describe command should have correct name")
+	assert.Equal(t, constants.AppCmdShort, describe.Short, "describe command should have correct short description")
+	assert.True(t, describe.Flags().Lookup(constants.OutputFlagName) != nil, "describe command should have output flag")
+}
@@ -0,0 +1,93 @@
+package apps
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/archive"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/snapshot"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for exporting apps
+	export = &cobra.Command{
+		Use:   constants.AppCmdUse + " <id>",
+		Short: constants.AppCmdShort,
+		Long: `Export an application to a portable archive.
+The application, its environments, shared values, the latest deployment set of each
+environment and its pipeline definitions are written to a .tar.gz/.tgz archive or, for
+any other path, to a directory. Secret shared values cannot be read through the API;
+their keys are listed in the archive metadata so they can be set again after import.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Get flags
+			filename, err := cmd.Flags().GetString(constants.FilenameFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get filename flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			if err := validation.ID(validation.App, args[0]); err != nil {
+				return err
+			}
+
+			// Get organization ID from the flag or config
+			org, err := OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			snap, err := snapshot.Capture(client, args[0], snapshot.Options{IncludeSets: true, IncludePipelines: true})
+			if err != nil {
+				return err
+			}
+
+			meta, err := archive.Write(filename, snap, org)
+			if err != nil {
+				return err
+			}
+			for _, key := range meta.ExcludedSecrets {
+				fmt.Fprintf(cmd.ErrOrStderr(), "skipped value %s: secret values cannot be read\n", key)
+			}
+
+			// Print output
+			formatted, err := output.FormatSuccess(fmt.Sprintf("Application %s exported to %s", args[0], filename), outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(export)
+
+	// Add command-specific flags
+	export.Flags().StringP(constants.FilenameFlagName, constants.FilenameFlagShort, "", constants.ArchiveFlagHelp)
+
+	// Mark required flags
+	export.MarkFlagRequired(constants.FilenameFlagName)
+}
@@ -0,0 +1,57 @@
+package apps
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/archive"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/snapshot"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestExportAppCommandExecution verifies that an application is exported to an archive
+// and to a directory, and that secret values are left out.
+func TestExportAppCommandExecution(t *testing.T) {
+	mockClient := test.NewTemplateMockClient()
+	mockClient.Pipelines = []humanitec.Pipeline{{ID: "deploy", Name: "Deploy"}}
+	mockClient.PipelineDefinition = "apiVersion: pipeline.humanitec.io/v1beta1\nname: Deploy\n"
+	test.SetupMockClient(t, mockClient)
+
+	for _, name := range []string{"template.tar.gz", "template"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+
+			got, err := test.ExecuteCommand(t, export, export, []string{"template"}, map[string]string{
+				constants.FilenameFlagName: path,
+				constants.OrgFlagName:      "source-org",
+			})
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, "Application template exported to "+path+"\n", got)
+
+			snap, meta, err := archive.Read(path)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, "template", meta.App)
+			assert.Equal(t, "source-org", meta.Org)
+			assert.Equal(t, []string{"API_KEY"}, meta.ExcludedSecrets)
+			assert.Equal(t, "Template", snap.App.Name)
+			assert.Equal(t, []humanitec.Value{{Key: "REGION", Value: "eu-west-1"}}, snap.Values)
+			assert.Equal(t, "set-1", snap.Sets["development"].ID)
+			assert.Equal(t, []snapshot.PipelineDefinition{{ID: "deploy", Definition: mockClient.PipelineDefinition}}, snap.Pipelines)
+		})
+	}
+}
+
+// TestExportAppCommandConfiguration verifies that the export app command is properly configured
+// with the correct name, description, and flags.
+func TestExportAppCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.AppCmdUse, export.Name(), "export command should have correct use")
+	assert.Equal(t, constants.AppCmdShort, export.Short, "export command should have correct short description")
+	assert.True(t, export.Flags().Lookup(constants.FilenameFlagName) != nil, "export command should have filename flag")
+}
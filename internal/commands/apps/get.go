@@ -2,7 +2,9 @@ package apps
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
@@ -13,13 +15,18 @@ import (
 var (
 	// Subcommand for getting apps
 	get = &cobra.Command{
-		Use:   constants.AppsCmdUse,
-		Short: constants.AppsCmdShort,
+		Use:     constants.AppsCmdUse + " [id...]",
+		Aliases: []string{constants.AppCmdUse},
+		Short:   constants.AppsCmdShort,
+		Long: `List applications in the organization, or get applications by ID.
+With --watch the list is refreshed every --interval and changes are printed as ADDED,
+MODIFIED and DELETED events until interrupted. When writing a table to a terminal, the
+whole table is redrawn instead.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// Get flags
-			id, err := cmd.Flags().GetString(constants.IDFlagName)
+			// Get application IDs from the --id flag and positional arguments
+			ids, err := appIDs(cmd, args)
 			if err != nil {
-				return fmt.Errorf("failed to get id flag: %w", err)
+				return err
 			}
 
 			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
@@ -33,16 +40,36 @@ var (
 				return fmt.Errorf("invalid output format: %w", err)
 			}
 
-			// Get organization ID from config
-			org := config.GetConfig().HumanitecOrg
+			watchChanges, interval, err := WatchSettings(cmd)
+			if err != nil {
+				return err
+			}
+			if watchChanges && len(ids) > 0 {
+				return clierrors.Validationf("--%s is only supported when listing all applications", constants.WatchFlagName)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := OrgID(cmd)
+			if err != nil {
+				return err
+			}
 			token := config.GetConfig().HumanitecToken
 
 			// Create Humanitec client
 			client := humanitec.NewClient(token, org)
 
+			if watchChanges {
+				return watchApps(cmd, client, outputFormat, interval)
+			}
+
+			// If several IDs are provided, get them concurrently
+			if len(ids) > 1 {
+				return getApps(cmd, client, ids, outputFormat)
+			}
+
 			// If ID is provided, get single app
-			if id != "" {
-				app, err := client.GetApp(id)
+			if len(ids) == 1 {
+				app, err := client.GetApp(ids[0])
 				if err != nil {
 					return fmt.Errorf("failed to get app: %w", err)
 				}
@@ -57,6 +84,17 @@ var (
 				return nil
 			}
 
+			// Stream NDJSON output as pages arrive instead of buffering the whole list
+			if outputFormat == output.FormatNDJSON {
+				writer := output.NewNDJSONWriter(cmd.OutOrStdout())
+				if err := client.WalkApps(func(app humanitec.App) error {
+					return writer.Write(app)
+				}); err != nil {
+					return fmt.Errorf("failed to list apps: %w", err)
+				}
+				return nil
+			}
+
 			// Otherwise, list all apps
 			apps, err := client.GetApps()
 			if err != nil {
@@ -75,10 +113,79 @@ var (
 	}
 )
 
+// getApps fetches several applications concurrently. The applications that were found are
+// printed together and every failure is reported without aborting the other lookups: machine
+// formats include the failures in the output, table formats print them to standard error.
+func getApps(cmd *cobra.Command, client humanitec.Client, ids []string, outputFormat output.Format) error {
+	apps := make([]*humanitec.App, len(ids))
+	_, errs := forEachID(ids, func(i int, id string) error {
+		app, err := client.GetApp(id)
+		apps[i] = app
+		return err
+	})
+
+	var found []humanitec.App
+	var failures []output.ErrorDetail
+	for i, app := range apps {
+		if errs[i] != nil {
+			failures = append(failures, output.NewErrorDetail(ids[i], errs[i]))
+			if !output.IsMachineFormat(outputFormat) {
+				cmd.PrintErrln(output.ErrorText(cmd.ErrOrStderr(), fmt.Sprintf("Error: failed to get app %s: %v", ids[i], errs[i])))
+			}
+			continue
+		}
+		found = append(found, *app)
+	}
+
+	formatted, err := output.FormatAppLookup(found, failures, outputFormat)
+	if err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+	fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+	return summaryError(cmd, "get", errs)
+}
+
+// watchApps prints the applications and then the changes to them until the command is interrupted.
+// On a terminal the table is redrawn on every change; otherwise every change is printed as an event.
+func watchApps(cmd *cobra.Command, client humanitec.Client, outputFormat output.Format, interval time.Duration) error {
+	err := output.Watch(cmd.Context(), cmd.OutOrStdout(), interval, client.GetApps,
+		func(app humanitec.App) string { return app.ID }, output.FormatApps, outputFormat)
+	if err != nil {
+		return fmt.Errorf("failed to list apps: %w", err)
+	}
+	return nil
+}
+
+// WatchFlagSet returns a function that adds the --watch and --interval flags to a list command
+func WatchFlagSet() func(*cobra.Command) {
+	return func(cmd *cobra.Command) {
+		cmd.Flags().BoolP(constants.WatchFlagName, constants.WatchFlagShort, false, constants.WatchFlagHelp)
+		cmd.Flags().Duration(constants.IntervalFlagName, constants.DefaultWaitInterval, constants.WatchIntervalFlagHelp)
+	}
+}
+
+// WatchSettings returns the --watch flag and the validated --interval flag
+func WatchSettings(cmd *cobra.Command) (watchChanges bool, interval time.Duration, err error) {
+	watchChanges, err = cmd.Flags().GetBool(constants.WatchFlagName)
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to get watch flag: %w", err)
+	}
+	interval, err = cmd.Flags().GetDuration(constants.IntervalFlagName)
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to get interval flag: %w", err)
+	}
+	if interval <= 0 {
+		return false, 0, clierrors.Validationf("--%s must be positive", constants.IntervalFlagName)
+	}
+	return watchChanges, interval, nil
+}
+
 func init() {
 	// Add common flags
 	CommonFlagSet()(get)
-	
+	WatchFlagSet()(get)
+
 	// Add command-specific flags
 	get.Flags().StringP(constants.IDFlagName, constants.IDFlagShort, "", constants.IDFlagHelp)
 }
\ No newline at end of file
@@ -1,8 +1,12 @@
 package apps
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
@@ -30,14 +34,14 @@ func TestGetAppCommandExecution(t *testing.T) {
 			name:           "get single app - json format",
 			args:           []string{},
 			flags:          map[string]string{constants.IDFlagName: "test-app", constants.OutputFlagName: "json"},
-			expectedOutput: "{\n  \"id\": \"test-app\",\n  \"name\": \"test-app\"\n}\n",
+			expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"App\",\n  \"item\": {\n    \"id\": \"test-app\",\n    \"name\": \"test-app\"\n  }\n}\n",
 			expectedError:  false,
 		},
 		{
 			name:           "get single app - yaml format",
 			args:           []string{},
 			flags:          map[string]string{constants.IDFlagName: "test-app", constants.OutputFlagName: "yaml"},
-			expectedOutput: "id: test-app\nname: test-app\n",
+			expectedOutput: "apiVersion: humctl-wrapper/v1\nkind: App\nitem:\n    id: test-app\n    name: test-app\n",
 			expectedError:  false,
 		},
 		{
@@ -51,28 +55,42 @@ func TestGetAppCommandExecution(t *testing.T) {
 			name:           "list all apps - json format",
 			args:           []string{},
 			flags:          map[string]string{constants.OutputFlagName: "json"},
-			expectedOutput: "{\n  \"id\": \"test-app\",\n  \"name\": \"test-app\"\n}\n",
+			expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"AppList\",\n  \"items\": [\n    {\n      \"id\": \"test-app\",\n      \"name\": \"test-app\"\n    }\n  ]\n}\n",
 			expectedError:  false,
 		},
 		{
 			name:           "list all apps - yaml format",
 			args:           []string{},
 			flags:          map[string]string{constants.OutputFlagName: "yaml"},
-			expectedOutput: "id: test-app\nname: test-app\n",
+			expectedOutput: "apiVersion: humctl-wrapper/v1\nkind: AppList\nitems:\n    - id: test-app\n      name: test-app\n",
+			expectedError:  false,
+		},
+		{
+			name:           "get single app - ndjson format",
+			args:           []string{},
+			flags:          map[string]string{constants.IDFlagName: "test-app", constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"id\":\"test-app\",\"name\":\"test-app\"}\n",
+			expectedError:  false,
+		},
+		{
+			name:           "list all apps - ndjson format",
+			args:           []string{},
+			flags:          map[string]string{constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"id\":\"test-app\",\"name\":\"test-app\"}\n",
 			expectedError:  false,
 		},
 		{
 			name:           "invalid output format",
 			args:           []string{},
 			flags:          map[string]string{constants.IDFlagName: "test-app", constants.OutputFlagName: "invalid"},
-			expectedOutput: "Usage:\n  test apps apps [flags]\n\nFlags:\n  -h, --help            help for apps\n  -i, --id string       Application ID\n  -g, --org string      Humanitec organization ID (defaults to %s environment variable)\n  -o, --output string   Output format (table|json|yaml) (default \"table\")\n\n",
+			expectedOutput: "Usage:\n  test apps apps [id...] [flags]\n\nFlags:\n  -h, --help                help for apps\n  -i, --id string           Application ID\n      --interval duration   Time between checks for changes with --watch (default 2s)\n  -g, --org string          Humanitec organization ID (defaults to %s environment variable)\n  -o, --output string       Output format (table|wide|json|yaml|ndjson) (default \"table\")\n  -w, --watch               Watch for changes and print them as they happen\n\n",
 			expectedError:  true,
 		},
 		{
 			name:           "api error",
 			args:           []string{},
 			flags:          map[string]string{constants.IDFlagName: "test-app", constants.OutputFlagName: "table"},
-			expectedOutput: "Usage:\n  test apps apps [flags]\n\nFlags:\n  -h, --help            help for apps\n  -i, --id string       Application ID\n  -g, --org string      Humanitec organization ID (defaults to %s environment variable)\n  -o, --output string   Output format (table|json|yaml) (default \"table\")\n\n",
+			expectedOutput: "Usage:\n  test apps apps [id...] [flags]\n\nFlags:\n  -h, --help                help for apps\n  -i, --id string           Application ID\n      --interval duration   Time between checks for changes with --watch (default 2s)\n  -g, --org string          Humanitec organization ID (defaults to %s environment variable)\n  -o, --output string       Output format (table|wide|json|yaml|ndjson) (default \"table\")\n  -w, --watch               Watch for changes and print them as they happen\n\n",
 			expectedError:  true,
 			mockError:      assert.AnError,
 		},
@@ -116,9 +134,167 @@ func TestGetAppCommandExecution(t *testing.T) {
 
 func TestGetAppCommandConfiguration(t *testing.T) {
 	// Test command configuration
-	assert.Equal(t, constants.AppsCmdUse, get.Use)
+	assert.Equal(t, constants.AppsCmdUse, get.Name())
 	assert.Equal(t, constants.AppsCmdShort, get.Short)
 
 	// Test required flags
 	assert.True(t, get.Flags().Lookup(constants.OutputFlagName) != nil)
-} 
\ No newline at end of file
+} 
+// TestGetAppRichFields verifies that creation details, environments and fields
+// unknown to the model are shown in wide output and preserved in JSON and YAML.
+func TestGetAppRichFields(t *testing.T) {
+	app := humanitec.App{
+		ID:        "test-app",
+		Name:      "Test App",
+		CreatedAt: "2024-01-02T03:04:05Z",
+		CreatedBy: "user-1",
+		Envs:      []humanitec.EnvSummary{{ID: "development", Name: "Development", Type: "development"}},
+		Extra:     map[string]interface{}{"owner": "team-a"},
+	}
+
+	testCases := []struct {
+		name           string
+		flags          map[string]string
+		expectedOutput string
+	}{
+		{
+			name:           "wide format",
+			flags:          map[string]string{constants.OutputFlagName: "wide"},
+			expectedOutput: "NAME\tID\tCREATED AT\tCREATED BY\tENVS\n----\t--\t----------\t----------\t----\nTest App\ttest-app\t2024-01-02T03:04:05Z\tuser-1\tdevelopment(development)\n",
+		},
+		{
+			name:           "ndjson format",
+			flags:          map[string]string{constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"id\":\"test-app\",\"name\":\"Test App\",\"created_at\":\"2024-01-02T03:04:05Z\",\"created_by\":\"user-1\",\"envs\":[{\"id\":\"development\",\"name\":\"Development\",\"type\":\"development\"}],\"owner\":\"team-a\"}\n",
+		},
+		{
+			name:           "yaml format",
+			flags:          map[string]string{constants.IDFlagName: "test-app", constants.OutputFlagName: "yaml"},
+			expectedOutput: "apiVersion: humctl-wrapper/v1\nkind: App\nitem:\n    id: test-app\n    name: Test App\n    created_at: \"2024-01-02T03:04:05Z\"\n    created_by: user-1\n    envs:\n        - id: development\n          name: Development\n          type: development\n    owner: team-a\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			test.SetupMockClient(t, &test.MockClient{App: &app, Apps: []humanitec.App{app}})
+
+			output, err := test.ExecuteCommand(t, get, get, []string{}, tc.flags)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedOutput, output)
+		})
+	}
+
+	// Unknown fields survive a JSON round trip
+	var decoded humanitec.App
+	assert.NoError(t, json.Unmarshal([]byte(`{"id":"a","name":"A","owner":"team-a"}`), &decoded))
+	assert.Equal(t, map[string]interface{}{"owner": "team-a"}, decoded.Extra)
+}
+
+// TestGetAppMultipleIDs verifies that several applications can be fetched at once, that the
+// ones that were found are printed even if another lookup fails and that machine formats
+// report the failed lookups.
+func TestGetAppMultipleIDs(t *testing.T) {
+	testCases := []struct {
+		name           string
+		format         string
+		expectedOutput string
+	}{
+		{
+			name:           "table format",
+			format:         "table",
+			expectedOutput: "NAME\tID\n----\t--\nApp A\tapp-a\nApp B\tapp-b\n",
+		},
+		{
+			name:   "json format",
+			format: "json",
+			expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"AppList\",\n  \"items\": [\n" +
+				"    {\n      \"id\": \"app-a\",\n      \"name\": \"App A\"\n    },\n" +
+				"    {\n      \"id\": \"app-b\",\n      \"name\": \"App B\"\n    }\n  ],\n" +
+				"  \"errors\": [\n    {\n      \"id\": \"missing-app\",\n      \"code\": \"NotFound\",\n" +
+				"      \"message\": \"API request failed with status 404: application not found\",\n      \"status\": 404\n    }\n  ]\n}\n",
+		},
+		{
+			name:   "ndjson format",
+			format: "ndjson",
+			expectedOutput: "{\"id\":\"app-a\",\"name\":\"App A\"}\n" +
+				"{\"id\":\"app-b\",\"name\":\"App B\"}\n" +
+				"{\"apiVersion\":\"humctl-wrapper/v1\",\"kind\":\"Error\",\"error\":{\"id\":\"missing-app\",\"code\":\"NotFound\",\"message\":\"API request failed with status 404: application not found\",\"status\":404}}\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			test.SetupMockClient(t, &test.MockClient{
+				AppsByID: map[string]*humanitec.App{
+					"app-a": {ID: "app-a", Name: "App A"},
+					"app-b": {ID: "app-b", Name: "App B"},
+				},
+				AppErrors: map[string]error{"missing-app": &humanitec.APIError{StatusCode: 404, Message: "application not found"}},
+			})
+
+			output, err := test.ExecuteCommand(t, get, get, []string{"app-a", "missing-app", "app-b"}, map[string]string{constants.OutputFlagName: tc.format})
+
+			assert.Error(t, err)
+			assert.True(t, clierrors.IsSilent(err), "failures are part of the output and should not be reported again")
+			assert.Equal(t, tc.expectedOutput, output)
+		})
+	}
+}
+
+// TestGetAppsWatch verifies that --watch prints the initial list as ADDED events followed
+// by the changes between listings, in every output format.
+func TestGetAppsWatch(t *testing.T) {
+	testCases := []struct {
+		name           string
+		format         string
+		expectedOutput string
+	}{
+		{
+			name:   "table format",
+			format: "table",
+			expectedOutput: "EVENT\tNAME\tID\n-----\t----\t--\n" +
+				"ADDED\tApp A\tapp-a\n" +
+				"ADDED\tApp B\tapp-b\n" +
+				"MODIFIED\tRenamed B\tapp-b\n" +
+				"DELETED\tApp A\tapp-a\n",
+		},
+		{
+			name:   "ndjson format",
+			format: "ndjson",
+			expectedOutput: "{\"type\":\"ADDED\",\"object\":{\"id\":\"app-a\",\"name\":\"App A\"}}\n" +
+				"{\"type\":\"ADDED\",\"object\":{\"id\":\"app-b\",\"name\":\"App B\"}}\n" +
+				"{\"type\":\"MODIFIED\",\"object\":{\"id\":\"app-b\",\"name\":\"Renamed B\"}}\n" +
+				"{\"type\":\"DELETED\",\"object\":{\"id\":\"app-a\",\"name\":\"App A\"}}\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appA := humanitec.App{ID: "app-a", Name: "App A"}
+			test.SetupMockClient(t, &test.MockClient{AppLists: [][]humanitec.App{
+				{appA, {ID: "app-b", Name: "App B"}},
+				{appA, {ID: "app-b", Name: "App B"}},
+				{appA, {ID: "app-b", Name: "Renamed B"}},
+				{{ID: "app-b", Name: "Renamed B"}},
+			}})
+
+			// Watching runs until interrupted, so stop it once all lists have been seen
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			output, err := test.ExecuteCommandContext(t, ctx, get, get, []string{}, map[string]string{
+				constants.WatchFlagName:    "true",
+				constants.IntervalFlagName: "1ms",
+				constants.OutputFlagName:   tc.format,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedOutput, output)
+		})
+	}
+
+	// Watching a single application is not supported
+	_, err := test.ExecuteCommand(t, get, get, []string{"app-a"}, map[string]string{constants.WatchFlagName: "true"})
+	assert.Error(t, err)
+}
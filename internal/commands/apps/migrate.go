@@ -0,0 +1,239 @@
+package apps
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/poll"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/snapshot"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for migrating apps to a new ID
+	migrate = &cobra.Command{
+		Use:   constants.AppCmdUse,
+		Short: constants.AppCmdShort,
+		Long: `Move an application to a new ID.
+Application IDs cannot be changed, so the application, its environments, shared values and
+pipelines and the latest deployment of each environment are copied to a new application. Once
+the deployments of the copy have finished, within --timeout, the copy is compared with the
+original, including the deployment set of each environment and the pipelines. With
+--delete-source the original application is deleted once the copy has been verified, after
+typing its ID to confirm or with --yes. Secret shared values cannot be read through the API and
+must be set again on the new application, so if the original has any, --delete-source also
+requires --discard-secrets.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Get flags
+			from, err := cmd.Flags().GetString(constants.FromFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get from flag: %w", err)
+			}
+
+			to, err := cmd.Flags().GetString(constants.ToFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get to flag: %w", err)
+			}
+
+			name, err := cmd.Flags().GetString(constants.NameFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get name flag: %w", err)
+			}
+
+			deleteSource, err := cmd.Flags().GetBool(constants.DeleteSourceFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get delete-source flag: %w", err)
+			}
+
+			discardSecrets, err := cmd.Flags().GetBool(constants.DiscardSecretsFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get discard-secrets flag: %w", err)
+			}
+
+			yes, err := cmd.Flags().GetBool(constants.YesFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get yes flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			timeout, interval, err := WaitTimings(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Validate input before calling the API
+			if err := validation.ID(validation.App, from); err != nil {
+				return err
+			}
+			if err := validation.ID(validation.App, to); err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(constants.NameFlagName) {
+				if err := validation.Name(name); err != nil {
+					return err
+				}
+			}
+
+			// Make sure the deletion can be confirmed before copying anything
+			confirmer := prompt.NewConfirmer(cmd.InOrStdin(), cmd.ErrOrStderr(), yes)
+			if deleteSource {
+				if err := confirmer.Check("delete the source application"); err != nil {
+					return err
+				}
+			}
+
+			// Get organization ID from the flag or config
+			org, err := OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			source, err := snapshot.Capture(client, from, snapshot.Options{IncludeSets: true, IncludePipelines: true})
+			if err != nil {
+				return err
+			}
+			if name == "" {
+				name = source.App.Name
+			}
+
+			// Secret values cannot be copied, so deleting the original would lose them
+			var secrets []string
+			for _, value := range source.Values {
+				if value.IsSecret {
+					secrets = append(secrets, value.Key)
+				}
+			}
+			if deleteSource && len(secrets) > 0 && !discardSecrets {
+				return clierrors.Validationf("app %s has secret shared values that cannot be copied: %s. Use --%s to delete it anyway",
+					from, strings.Join(secrets, ", "), constants.DiscardSecretsFlagName)
+			}
+
+			// Record the deployments of the copy to wait for them before verifying it
+			deployments := map[string]string{}
+			printProgress := PrintProgress(cmd.ErrOrStderr())
+			app, err := snapshot.Restore(client, source, to, name, func(step snapshot.Step) {
+				if step.DeployID != "" {
+					deployments[step.ID] = step.DeployID
+				}
+				printProgress(step)
+			})
+			if err != nil {
+				return fmt.Errorf("failed to migrate app %s: %w", from, err)
+			}
+
+			if err := waitForDeployments(client, to, deployments, timeout, interval, cmd.ErrOrStderr()); err != nil {
+				return fmt.Errorf("failed to verify app %s, keeping both: %w", to, err)
+			}
+
+			// Verify that the copy matches the original before touching the original
+			copied, err := snapshot.Capture(client, to, snapshot.Options{IncludeSets: true, IncludePipelines: true})
+			if err != nil {
+				return fmt.Errorf("failed to verify app %s: %w", to, err)
+			}
+			if diffs := snapshot.Compare(source, copied); len(diffs) > 0 {
+				return fmt.Errorf("app %s does not match app %s, keeping both: %s", to, from, strings.Join(diffs, "; "))
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "verified app %s matches app %s\n", to, from)
+
+			if deleteSource {
+				fmt.Fprintf(cmd.ErrOrStderr(), "The original application %s and all of its environments will be deleted.\n", from)
+				if len(secrets) > 0 {
+					fmt.Fprintf(cmd.ErrOrStderr(), "Its secret shared values were not copied and will be lost: %s\n", strings.Join(secrets, ", "))
+				}
+				confirmed, err := confirmer.ConfirmByTyping(from)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					return fmt.Errorf("app %s was kept: %w", from, prompt.ErrAborted)
+				}
+				if err := client.DeleteApp(from); err != nil {
+					return fmt.Errorf("app %s was migrated but deleting the original failed: %w", to, err)
+				}
+				fmt.Fprintf(cmd.ErrOrStderr(), "deleted app %s\n", from)
+			}
+
+			// Print output
+			formatted, err := output.FormatApp(app, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+// waitForDeployments polls the deployments of an application, keyed by environment ID, until
+// all of them have finished, printing each one that does to w. It fails if any deployment failed.
+func waitForDeployments(client humanitec.Client, app string, deployments map[string]string, timeout, interval time.Duration, w io.Writer) error {
+	pending := make([]string, 0, len(deployments))
+	for env := range deployments {
+		pending = append(pending, env)
+	}
+	sort.Strings(pending)
+
+	return poll.Until(timeout, interval, func() (bool, error) {
+		var running []string
+		for _, env := range pending {
+			id := deployments[env]
+			deployment, err := client.GetDeployment(app, env, id)
+			if err != nil {
+				return false, fmt.Errorf("failed to get deployment %s of environment %s: %w", id, env, err)
+			}
+			if !deployment.IsDone() {
+				running = append(running, env)
+				continue
+			}
+			fmt.Fprintf(w, "deployment %s of environment %s: %s\n", id, env, deployment.Status)
+			if deployment.Status == humanitec.DeploymentFailed {
+				return false, fmt.Errorf("deployment %s of environment %s failed", id, env)
+			}
+		}
+		pending = running
+		return len(pending) == 0, nil
+	})
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(migrate)
+	WaitFlagSet()(migrate)
+
+	// Add command-specific flags
+	migrate.Flags().String(constants.FromFlagName, "", constants.FromFlagHelp)
+	migrate.Flags().String(constants.ToFlagName, "", constants.ToFlagHelp)
+	migrate.Flags().StringP(constants.NameFlagName, constants.NameFlagShort, "", constants.NameFlagHelp)
+	migrate.Flags().Bool(constants.DeleteSourceFlagName, false, constants.DeleteSourceFlagHelp)
+	migrate.Flags().Bool(constants.DiscardSecretsFlagName, false, constants.DiscardSecretsFlagHelp)
+	migrate.Flags().BoolP(constants.YesFlagName, constants.YesFlagShort, false, constants.YesFlagHelp)
+
+	// Mark required flags
+	migrate.MarkFlagRequired(constants.FromFlagName)
+	migrate.MarkFlagRequired(constants.ToFlagName)
+}
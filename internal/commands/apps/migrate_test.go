@@ -0,0 +1,188 @@
+package apps
+
+import (
+	"io"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMigrateAppCommandExecution verifies that the migrate app command copies an application
+// to a new ID and only deletes the original when asked to.
+func TestMigrateAppCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name            string
+		flags           map[string]string
+		expectedOutput  string
+		expectedDeleted []string
+		expectError     bool
+	}{
+		{
+			name: "keep source",
+			flags: map[string]string{
+				constants.FromFlagName: "template",
+				constants.ToFlagName:   "new-app",
+			},
+			expectedOutput: "NAME\tID\n----\t--\nNew App\tnew-app\n",
+		},
+		{
+			name: "delete source",
+			flags: map[string]string{
+				constants.FromFlagName:           "template",
+				constants.ToFlagName:             "new-app",
+				constants.DeleteSourceFlagName:   "true",
+				constants.DiscardSecretsFlagName: "true",
+				constants.YesFlagName:            "true",
+			},
+			expectedOutput:  "NAME\tID\n----\t--\nNew App\tnew-app\n",
+			expectedDeleted: []string{"template"},
+		},
+		{
+			name: "invalid to",
+			flags: map[string]string{
+				constants.FromFlagName: "template",
+				constants.ToFlagName:   "New_App",
+			},
+			expectError: true,
+		},
+		{
+			name:        "missing to flag",
+			flags:       map[string]string{constants.FromFlagName: "template"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := test.NewTemplateMockClient()
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommand(t, migrate, migrate, []string{}, tt.flags)
+			if (err != nil) != tt.expectError {
+				t.Errorf("migrate.Execute() error = %v, wantErr %v", err, tt.expectError)
+				return
+			}
+			if !tt.expectError {
+				assert.Equal(t, tt.expectedOutput, got)
+				assert.Equal(t, tt.expectedDeleted, mockClient.Deleted)
+			}
+		})
+	}
+}
+
+// TestMigrateAppParityFailure verifies that the original application is kept when the
+// copy does not match it.
+func TestMigrateAppParityFailure(t *testing.T) {
+	mockClient := test.NewTemplateMockClient()
+	mockClient.ValuesByApp = map[string][]humanitec.Value{"new-app": {{Key: "REGION", Value: "us-east-1"}}}
+	test.SetupMockClient(t, mockClient)
+
+	_, err := test.ExecuteCommand(t, migrate, migrate, []string{}, map[string]string{
+		constants.FromFlagName:           "template",
+		constants.ToFlagName:             "new-app",
+		constants.DeleteSourceFlagName:   "true",
+		constants.DiscardSecretsFlagName: "true",
+		constants.YesFlagName:            "true",
+	})
+
+	assert.ErrorContains(t, err, `value REGION is "us-east-1", want "eu-west-1"`)
+	assert.Empty(t, mockClient.Deleted)
+}
+
+// TestMigrateAppSecretValues verifies that an application with secret shared values is only
+// deleted with --discard-secrets, and that nothing is copied without it.
+func TestMigrateAppSecretValues(t *testing.T) {
+	mockClient := test.NewTemplateMockClient()
+	test.SetupMockClient(t, mockClient)
+
+	_, err := test.ExecuteCommand(t, migrate, migrate, []string{}, map[string]string{
+		constants.FromFlagName:         "template",
+		constants.ToFlagName:           "new-app",
+		constants.DeleteSourceFlagName: "true",
+		constants.YesFlagName:          "true",
+	})
+
+	assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(err))
+	assert.ErrorContains(t, err, "API_KEY")
+	assert.Empty(t, mockClient.Created)
+	assert.Empty(t, mockClient.Deleted)
+}
+
+// TestMigrateAppDeploymentParity verifies that the original application is kept when a
+// deployment of the copy fails or deploys a different set.
+func TestMigrateAppDeploymentParity(t *testing.T) {
+	flags := map[string]string{
+		constants.FromFlagName:           "template",
+		constants.ToFlagName:             "new-app",
+		constants.DeleteSourceFlagName:   "true",
+		constants.DiscardSecretsFlagName: "true",
+		constants.YesFlagName:            "true",
+		constants.IntervalFlagName:       "1ms",
+	}
+
+	mockClient := test.NewTemplateMockClient()
+	mockClient.DeploymentStatuses = []string{humanitec.DeploymentInProgress, humanitec.DeploymentFailed}
+	test.SetupMockClient(t, mockClient)
+	_, err := test.ExecuteCommand(t, migrate, migrate, []string{}, flags)
+	assert.ErrorContains(t, err, "deployment deploy-development of environment development failed")
+	assert.Empty(t, mockClient.Deleted)
+
+	mockClient = test.NewTemplateMockClient()
+	mockClient.EnvsByApp = map[string][]humanitec.Environment{
+		"new-app": {{ID: "development", Name: "Development", Type: "development", LastDeploy: &humanitec.Deployment{ID: "deploy-development", SetID: "set-2"}}},
+	}
+	mockClient.Sets = map[string]*humanitec.DeploymentSet{"set-2": {ID: "set-2", Modules: map[string]interface{}{"worker": map[string]interface{}{}}}}
+	test.SetupMockClient(t, mockClient)
+	_, err = test.ExecuteCommand(t, migrate, migrate, []string{}, flags)
+	assert.ErrorContains(t, err, "deployment set of environment development differs")
+	assert.Empty(t, mockClient.Deleted)
+}
+
+// TestMigrateAppConfirmation verifies that deleting the original application must be
+// confirmed, and that nothing is copied when it cannot be.
+func TestMigrateAppConfirmation(t *testing.T) {
+	flags := map[string]string{
+		constants.FromFlagName:           "template",
+		constants.ToFlagName:             "new-app",
+		constants.DeleteSourceFlagName:   "true",
+		constants.DiscardSecretsFlagName: "true",
+	}
+
+	// Input is not a terminal and --yes is not given
+	mockClient := test.NewTemplateMockClient()
+	test.SetupMockClient(t, mockClient)
+	_, err := test.ExecuteCommand(t, migrate, migrate, []string{}, flags)
+	assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(err))
+	assert.Empty(t, mockClient.Deleted)
+
+	isInteractive := prompt.IsInteractive
+	prompt.IsInteractive = func(io.Reader) bool { return true }
+	defer func() { prompt.IsInteractive = isInteractive }()
+
+	// Declining keeps the original application
+	_, err = test.ExecuteCommandWithInput(t, migrate, migrate, []string{}, flags, "no\n")
+	assert.ErrorIs(t, err, prompt.ErrAborted)
+	assert.Empty(t, mockClient.Deleted)
+
+	// Typing the ID deletes it
+	_, err = test.ExecuteCommandWithInput(t, migrate, migrate, []string{}, flags, "template\n")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"template"}, mockClient.Deleted)
+}
+
+// TestMigrateAppCommandConfiguration verifies that the migrate app command is properly configured
+// with the correct name, description, and flags.
+func TestMigrateAppCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.AppCmdUse, migrate.Name(), "migrate command should have correct use")
+	assert.Equal(t, constants.AppCmdShort, migrate.Short, "migrate command should have correct short description")
+
+	assert.True(t, migrate.Flags().Lookup(constants.FromFlagName) != nil, "migrate command should have from flag")
+	assert.True(t, migrate.Flags().Lookup(constants.ToFlagName) != nil, "migrate command should have to flag")
+	assert.True(t, migrate.Flags().Lookup(constants.DeleteSourceFlagName) != nil, "migrate command should have delete-source flag")
+	assert.True(t, migrate.Flags().Lookup(constants.TimeoutFlagName) != nil, "migrate command should have timeout flag")
+}
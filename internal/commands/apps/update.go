@@ -3,25 +3,34 @@ package apps
 import (
 	"fmt"
 
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
 	"github.com/spf13/cobra"
 )
 
 var (
 	// Subcommand for updating apps
 	update = &cobra.Command{
-		Use:   constants.AppCmdUse,
-		Short: constants.AppCmdShort,
+		Use:     constants.AppCmdUse + " [id...]",
+		Aliases: []string{constants.AppsCmdUse},
+		Short:   constants.AppCmdShort,
 		Long: `Update an existing application in the organization.
-Currently supports updating the application name while preserving all other settings and configurations.`,
+Currently supports updating the application name while preserving all other settings and configurations.
+Application IDs can be given with --id and as positional arguments; several applications are updated
+concurrently and a per-application summary is printed.
+With --dry-run the requests are validated and printed instead of being sent.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// Get flags
-			id, err := cmd.Flags().GetString(constants.IDFlagName)
+			// Get application IDs from the --id flag and positional arguments
+			ids, err := appIDs(cmd, args)
 			if err != nil {
-				return fmt.Errorf("failed to get id flag: %w", err)
+				return err
+			}
+			if len(ids) == 0 {
+				return clierrors.Validationf("at least one application ID is required")
 			}
 
 			name, err := cmd.Flags().GetString(constants.NameFlagName)
@@ -40,15 +49,56 @@ Currently supports updating the application name while preserving all other sett
 				return fmt.Errorf("invalid output format: %w", err)
 			}
 
-			// Get organization ID from config
-			org := config.GetConfig().HumanitecOrg
+			dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
+
+			// Validate input before calling the API
+			if err := validation.Name(name); err != nil {
+				return err
+			}
+			for _, id := range ids {
+				if err := validation.ID(validation.App, id); err != nil {
+					return err
+				}
+			}
+
+			// Get organization ID from the flag or config
+			org, err := OrgID(cmd)
+			if err != nil {
+				return err
+			}
 			token := config.GetConfig().HumanitecToken
 
+			// Print the requests instead of sending them on a dry run
+			if dryRun {
+				requests := make([]humanitec.Request, 0, len(ids))
+				for _, id := range ids {
+					requests = append(requests, humanitec.UpdateAppRequest(org, id, name))
+				}
+				return PrintRequests(cmd, requests, outputFormat)
+			}
+
 			// Create Humanitec client
 			client := humanitec.NewClient(token, org)
 
+			// Update several apps concurrently and report the outcome of each
+			if len(ids) > 1 {
+				results, errs := forEachID(ids, func(i int, id string) error {
+					_, err := client.UpdateApp(id, name)
+					return err
+				})
+				formatted, err := output.FormatResults(results, outputFormat)
+				if err != nil {
+					return fmt.Errorf("failed to format output: %w", err)
+				}
+				fmt.Fprint(cmd.OutOrStdout(), formatted)
+				return summaryError(cmd, "update", errs)
+			}
+
 			// Update app
-			app, err := client.UpdateApp(id, name)
+			app, err := client.UpdateApp(ids[0], name)
 			if err != nil {
 				return fmt.Errorf("failed to update app: %w", err)
 			}
@@ -72,8 +122,8 @@ func init() {
 	// Add command-specific flags
 	update.Flags().StringP(constants.IDFlagName, constants.IDFlagShort, "", constants.IDFlagHelp)
 	update.Flags().StringP(constants.NameFlagName, constants.NameFlagShort, "", constants.NameFlagHelp)
+	update.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
 
 	// Mark required flags
-	update.MarkFlagRequired(constants.IDFlagName)
 	update.MarkFlagRequired(constants.NameFlagName)
 } 
\ No newline at end of file
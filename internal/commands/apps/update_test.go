@@ -20,49 +20,49 @@ var updateTestCases = []struct {
 }{
 	{
 		name:           "table format",
-		args:           []string{"update"},
+		args:           []string{},
 		flags:          map[string]string{"id": "test-app", "name": "New App Name", "output": "table"},
 		expectedOutput: "NAME\tID\n----\t--\nNew App Name\ttest-app\n",
 		expectError:    false,
 	},
 	{
 		name:           "json format",
-		args:           []string{"update"},
+		args:           []string{},
 		flags:          map[string]string{"id": "test-app", "name": "New App Name", "output": "json"},
-		expectedOutput: "{\n  \"id\": \"test-app\",\n  \"name\": \"New App Name\"\n}\n",
+		expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"App\",\n  \"item\": {\n    \"id\": \"test-app\",\n    \"name\": \"New App Name\"\n  }\n}\n",
 		expectError:    false,
 	},
 	{
 		name:           "yaml format",
-		args:           []string{"update"},
+		args:           []string{},
 		flags:          map[string]string{"id": "test-app", "name": "New App Name", "output": "yaml"},
-		expectedOutput: "id: test-app\nname: New App Name\n",
+		expectedOutput: "apiVersion: humctl-wrapper/v1\nkind: App\nitem:\n    id: test-app\n    name: New App Name\n",
 		expectError:    false,
 	},
 	{
 		name:           "missing id flag",
-		args:           []string{"update"},
+		args:           []string{},
 		flags:          map[string]string{"name": "New App Name"},
 		expectedOutput: "",
 		expectError:    true,
 	},
 	{
 		name:           "missing name flag",
-		args:           []string{"update"},
+		args:           []string{},
 		flags:          map[string]string{"id": "test-app"},
 		expectedOutput: "",
 		expectError:    true,
 	},
 	{
 		name:           "invalid output format",
-		args:           []string{"update"},
+		args:           []string{},
 		flags:          map[string]string{"id": "test-app", "name": "New App Name", "output": "invalid"},
 		expectedOutput: "",
 		expectError:    true,
 	},
 	{
 		name:           "api error",
-		args:           []string{"update"},
+		args:           []string{},
 		flags:          map[string]string{"id": "error-app", "name": "New App Name", "output": "table"},
 		expectedOutput: "",
 		expectError:    true,
@@ -105,7 +105,7 @@ func TestUpdateAppCommandExecution(t *testing.T) {
 // with the correct name, description, and required flags.
 func TestUpdateAppCommandConfiguration(t *testing.T) {
 	// Test update command
-	assert.Equal(t, constants.AppCmdUse, update.Use, "update command should have correct use")
+	assert.Equal(t, constants.AppCmdUse, update.Name(), "update command should have correct use")
 	assert.Equal(t, constants.AppCmdShort, update.Short, "update command should have correct short description")
 
 	// Test flags
@@ -0,0 +1,133 @@
+package apps
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/poll"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+// Conditions supported by wait app
+const (
+	conditionExists  = "exists"
+	conditionDeleted = "deleted"
+)
+
+var (
+	// Subcommand for waiting on apps
+	wait = &cobra.Command{
+		Use:   constants.AppCmdUse + " <id>",
+		Short: constants.AppCmdShort,
+		Long: `Wait until an application exists or has been deleted.
+The application is checked immediately and then every --interval until the condition
+is met. If it is not met within --timeout, the command fails with exit code 7.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			condition, err := cmd.Flags().GetString(constants.ForFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get for flag: %w", err)
+			}
+			if condition != conditionExists && condition != conditionDeleted {
+				return clierrors.Validationf("unsupported condition: %s. Supported conditions: %s, %s", condition, conditionExists, conditionDeleted)
+			}
+
+			timeout, interval, err := WaitTimings(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			id := args[0]
+			if err := validation.ID(validation.App, id); err != nil {
+				return err
+			}
+
+			// Get organization ID from the flag or config
+			org, err := OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			err = poll.Until(timeout, interval, func() (bool, error) {
+				_, err := client.GetApp(id)
+				switch {
+				case humanitec.IsNotFound(err):
+					return condition == conditionDeleted, nil
+				case err != nil:
+					return false, err
+				default:
+					return condition == conditionExists, nil
+				}
+			})
+			if err != nil {
+				return fmt.Errorf("failed waiting for app %s to be %s: %w", id, condition, err)
+			}
+
+			// Print output
+			formatted, err := output.FormatSuccess(fmt.Sprintf("Application %s %s", id, condition), outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+// WaitFlagSet returns a function that adds the --timeout and --interval flags to a command
+func WaitFlagSet() func(*cobra.Command) {
+	return func(cmd *cobra.Command) {
+		cmd.Flags().Duration(constants.TimeoutFlagName, constants.DefaultWaitTimeout, constants.TimeoutFlagHelp)
+		cmd.Flags().Duration(constants.IntervalFlagName, constants.DefaultWaitInterval, constants.IntervalFlagHelp)
+	}
+}
+
+// WaitTimings returns the validated --timeout and --interval flags
+func WaitTimings(cmd *cobra.Command) (timeout, interval time.Duration, err error) {
+	timeout, err = cmd.Flags().GetDuration(constants.TimeoutFlagName)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get timeout flag: %w", err)
+	}
+	interval, err = cmd.Flags().GetDuration(constants.IntervalFlagName)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get interval flag: %w", err)
+	}
+	if timeout <= 0 || interval <= 0 {
+		return 0, 0, clierrors.Validationf("--%s and --%s must be positive", constants.TimeoutFlagName, constants.IntervalFlagName)
+	}
+	return timeout, interval, nil
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(wait)
+	WaitFlagSet()(wait)
+
+	// Add command-specific flags
+	wait.Flags().String(constants.ForFlagName, "", constants.ForFlagHelp+" ("+conditionExists+"|"+conditionDeleted+")")
+
+	// Mark required flags
+	wait.MarkFlagRequired(constants.ForFlagName)
+}
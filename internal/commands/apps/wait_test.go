@@ -0,0 +1,95 @@
+package apps
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWaitAppCommandExecution verifies that wait app returns once the condition is met,
+// fails with a distinct exit code on timeout and stops on API errors.
+func TestWaitAppCommandExecution(t *testing.T) {
+	notFound := &humanitec.APIError{StatusCode: 404, Message: "application not found"}
+
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		mockClient     *test.MockClient
+		expectedOutput string
+		expectedExit   int
+	}{
+		{
+			name:           "exists",
+			args:           []string{"test-app"},
+			flags:          map[string]string{constants.ForFlagName: "exists"},
+			mockClient:     &test.MockClient{App: &humanitec.App{ID: "test-app"}},
+			expectedOutput: "Application test-app exists\n",
+		},
+		{
+			name:           "deleted",
+			args:           []string{"old-app"},
+			flags:          map[string]string{constants.ForFlagName: "deleted", constants.OutputFlagName: "json"},
+			mockClient:     &test.MockClient{AppErrors: map[string]error{"old-app": notFound}},
+			expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"Message\",\n  \"item\": {\n    \"message\": \"Application old-app deleted\"\n  }\n}\n",
+		},
+		{
+			name: "timeout",
+			args: []string{"test-app"},
+			flags: map[string]string{
+				constants.ForFlagName:      "deleted",
+				constants.TimeoutFlagName:  "20ms",
+				constants.IntervalFlagName: "5ms",
+			},
+			mockClient:   &test.MockClient{App: &humanitec.App{ID: "test-app"}},
+			expectedExit: clierrors.ExitTimeout,
+		},
+		{
+			name:         "api error",
+			args:         []string{"test-app"},
+			flags:        map[string]string{constants.ForFlagName: "exists"},
+			mockClient:   &test.MockClient{Error: fmt.Errorf("API error")},
+			expectedExit: clierrors.ExitError,
+		},
+		{
+			name:         "unsupported condition",
+			args:         []string{"test-app"},
+			flags:        map[string]string{constants.ForFlagName: "ready"},
+			mockClient:   &test.MockClient{},
+			expectedExit: clierrors.ExitValidation,
+		},
+		{
+			name:         "invalid interval",
+			args:         []string{"test-app"},
+			flags:        map[string]string{constants.ForFlagName: "exists", constants.IntervalFlagName: "0s"},
+			mockClient:   &test.MockClient{},
+			expectedExit: clierrors.ExitValidation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			test.SetupMockClient(t, tt.mockClient)
+
+			got, err := test.ExecuteCommand(t, wait, wait, tt.args, tt.flags)
+			assert.Equal(t, tt.expectedExit, clierrors.ExitCode(err), "unexpected error: %v", err)
+			if tt.expectedExit == clierrors.ExitOK {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
+
+// TestWaitAppCommandConfiguration verifies that the wait app command is properly configured
+// with the correct name, description, and flags.
+func TestWaitAppCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.AppCmdUse, wait.Name(), "wait command should have correct use")
+	assert.True(t, wait.Flags().Lookup(constants.ForFlagName) != nil, "wait command should have for flag")
+	assert.True(t, wait.Flags().Lookup(constants.TimeoutFlagName) != nil, "wait command should have timeout flag")
+	assert.True(t, wait.Flags().Lookup(constants.IntervalFlagName) != nil, "wait command should have interval flag")
+}
@@ -0,0 +1,84 @@
+package deltas
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for archiving deltas
+	archive = &cobra.Command{
+		Use:   constants.DeltaCmdUse + " <id>",
+		Short: constants.DeltaCmdShort,
+		Long: `Archive a delta so that it is no longer listed with the active deltas.
+Archived deltas are kept and can be listed with get deltas --archived. Use --dry-run to print
+the request without sending it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := appID(cmd)
+			if err != nil {
+				return err
+			}
+
+			dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			id := args[0]
+
+			// Print the request instead of sending it on a dry run
+			if dryRun {
+				return apps.PrintRequests(cmd, []humanitec.Request{humanitec.ArchiveDeltaRequest(org, app, id)}, outputFormat)
+			}
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			if err := client.ArchiveDelta(app, id); err != nil {
+				return fmt.Errorf("failed to archive delta: %w", err)
+			}
+
+			// Print output
+			formatted, err := output.FormatSuccess(fmt.Sprintf("Delta %s archived", id), outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(archive)
+
+	// Add command-specific flags
+	archive.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
+}
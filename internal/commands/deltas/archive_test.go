@@ -0,0 +1,83 @@
+package deltas
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestArchiveDeltaCommandExecution verifies that archive delta archives a delta.
+func TestArchiveDeltaCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name             string
+		args             []string
+		flags            map[string]string
+		expectedOutput   string
+		expectedArchived []string
+		expectedErr      int
+	}{
+		{
+			name:             "archive",
+			args:             []string{"delta-1"},
+			flags:            map[string]string{constants.AppFlagName: "test-app"},
+			expectedOutput:   "Delta delta-1 archived\n",
+			expectedArchived: []string{"delta-1"},
+		},
+		{
+			name:           "dry run",
+			args:           []string{"delta-1"},
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.DryRunFlagName: "true", constants.OrgFlagName: "test-org"},
+			expectedOutput: "DRY RUN: PUT /orgs/test-org/apps/test-app/deltas/delta-1/metadata/archived\ntrue\n",
+		},
+		{
+			name:        "delta not found",
+			args:        []string{"delta-9"},
+			flags:       map[string]string{constants.AppFlagName: "test-app"},
+			expectedErr: clierrors.ExitNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &test.MockClient{
+				Deltas: []humanitec.Delta{
+					{
+						ID:       "delta-1",
+						Metadata: humanitec.DeltaMetadata{EnvID: "development", Name: "Add worker", CreatedAt: "2024-01-01T00:00:00Z", CreatedBy: "user-1"},
+						Modules: humanitec.DeltaModules{
+							Add: map[string]interface{}{"worker": map[string]interface{}{"profile": "default-module"}},
+							Update: map[string][]interface{}{"api": {
+								map[string]interface{}{"op": "replace", "path": "/spec/containers/api/image", "value": "api:1.1"},
+							}},
+						},
+					},
+					{
+						ID:       "delta-2",
+						Metadata: humanitec.DeltaMetadata{EnvID: "staging"},
+						Modules:  humanitec.DeltaModules{Remove: []string{"legacy"}},
+						Shared:   []interface{}{map[string]interface{}{"op": "remove", "path": "/dns"}},
+					},
+					{
+						ID:       "delta-3",
+						Metadata: humanitec.DeltaMetadata{EnvID: "development", Archived: true},
+						Modules:  humanitec.DeltaModules{Remove: []string{"api"}},
+					},
+				},
+			}
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommand(t, archive, archive, tt.args, tt.flags)
+			if tt.expectedErr != 0 {
+				assert.Equal(t, tt.expectedErr, clierrors.ExitCode(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, got)
+			assert.Equal(t, tt.expectedArchived, mockClient.ArchivedDeltas)
+		})
+	}
+}
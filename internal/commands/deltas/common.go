@@ -0,0 +1,87 @@
+package deltas
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+// stdin is the filename that reads from standard input
+const stdin = "-"
+
+// CommonFlagSet returns a function that adds the common flags and the required --app flag to a command
+func CommonFlagSet() func(*cobra.Command) {
+	return func(cmd *cobra.Command) {
+		apps.CommonFlagSet()(cmd)
+		cmd.Flags().StringP(constants.AppFlagName, constants.AppFlagShort, "", constants.AppIDFlagHelp)
+		cmd.MarkFlagRequired(constants.AppFlagName)
+	}
+}
+
+// appID returns the validated application ID given with --app
+func appID(cmd *cobra.Command) (string, error) {
+	app, err := cmd.Flags().GetString(constants.AppFlagName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get app flag: %w", err)
+	}
+	if err := validation.ID(validation.App, app); err != nil {
+		return "", err
+	}
+	return app, nil
+}
+
+// envID returns the environment ID given with --env, validated if it is set
+func envID(cmd *cobra.Command) (string, error) {
+	env, err := cmd.Flags().GetString(constants.EnvFlagName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get env flag: %w", err)
+	}
+	if env != "" {
+		if err := validation.ID(validation.Environment, env); err != nil {
+			return "", err
+		}
+	}
+	return env, nil
+}
+
+// validateWorkloads validates the IDs of the workloads that delta modules add, update and remove
+func validateWorkloads(modules humanitec.DeltaModules) error {
+	ids := make([]string, 0, len(modules.Add)+len(modules.Update)+len(modules.Remove))
+	for id := range modules.Add {
+		ids = append(ids, id)
+	}
+	for id := range modules.Update {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range append(ids, modules.Remove...) {
+		if err := validation.ID(validation.Workload, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readFile reads a file, or standard input if filename is "-"
+func readFile(filename string, in io.Reader) ([]byte, error) {
+	if filename == stdin {
+		data, err := io.ReadAll(in)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read standard input: %w", err)
+		}
+		return data, nil
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, clierrors.Validationf("failed to read %s: %v", filename, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,167 @@
+package deltas
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// Subcommand for creating deltas
+	create = &cobra.Command{
+		Use:   constants.DeltaCmdUse,
+		Short: constants.DeltaCmdShort,
+		Long: `Create a delta in an application from a YAML or JSON file.
+--env and --name override the environment and name in the metadata of the file. Use
+--dry-run to print the request without sending it.
+
+Example delta:
+
+  metadata:
+    env_id: development
+    name: Update api image
+  modules:
+    update:
+      api:
+        - op: replace
+          path: /spec/containers/api/image
+          value: registry.example.com/api:1.1`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := appID(cmd)
+			if err != nil {
+				return err
+			}
+
+			env, err := envID(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Get flags
+			filename, err := cmd.Flags().GetString(constants.FilenameFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get filename flag: %w", err)
+			}
+
+			name, err := cmd.Flags().GetString(constants.NameFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get name flag: %w", err)
+			}
+
+			dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Read and validate the delta before calling the API
+			data, err := readFile(filename, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			delta, err := decodeDelta(data, filename)
+			if err != nil {
+				return err
+			}
+			if env != "" {
+				delta.Metadata.EnvID = env
+			}
+			if name != "" {
+				delta.Metadata.Name = name
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Print the request instead of sending it on a dry run
+			if dryRun {
+				return apps.PrintRequests(cmd, []humanitec.Request{humanitec.CreateDeltaRequest(org, app, *delta)}, outputFormat)
+			}
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			created, err := client.CreateDelta(app, *delta)
+			if err != nil {
+				return fmt.Errorf("failed to create delta: %w", err)
+			}
+
+			// Print output
+			formatted, err := output.FormatDelta(created, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+// decodeDelta parses a delta in YAML or JSON, rejecting unknown fields, invalid workload IDs and
+// deltas that change nothing
+func decodeDelta(data []byte, source string) (*humanitec.Delta, error) {
+	var delta humanitec.Delta
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&delta); err != nil && !errors.Is(err, io.EOF) {
+		return nil, clierrors.Validationf("failed to parse delta %s: %v", source, err)
+	}
+
+	if delta.ID != "" {
+		return nil, clierrors.Validationf("delta %s must not set an id, it is assigned when the delta is created", source)
+	}
+	if delta.Metadata.EnvID != "" {
+		if err := validation.ID(validation.Environment, delta.Metadata.EnvID); err != nil {
+			return nil, err
+		}
+	}
+	modules := delta.Modules
+	if err := validateWorkloads(modules); err != nil {
+		return nil, err
+	}
+	if len(modules.Add) == 0 && len(modules.Remove) == 0 && len(modules.Update) == 0 && len(delta.Shared) == 0 {
+		return nil, clierrors.Validationf("delta %s does not add, update or remove anything", source)
+	}
+	return &delta, nil
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(create)
+
+	// Add command-specific flags
+	create.Flags().StringP(constants.FilenameFlagName, constants.FilenameFlagShort, "", constants.DeltaFileFlagHelp)
+	create.Flags().StringP(constants.EnvFlagName, constants.EnvFlagShort, "", constants.DeltaEnvFlagHelp)
+	create.Flags().StringP(constants.NameFlagName, constants.NameFlagShort, "", constants.DeltaNameFlagHelp)
+	create.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
+
+	// Mark required flags
+	create.MarkFlagRequired(constants.FilenameFlagName)
+}
@@ -0,0 +1,114 @@
+package deltas
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+const deltaYAML = `metadata:
+  env_id: development
+  name: Update api
+modules:
+  update:
+    api:
+      - op: replace
+        path: /spec/containers/api/image
+        value: api:1.1
+`
+
+// TestCreateDeltaCommandExecution verifies that create delta creates a delta from a file.
+func TestCreateDeltaCommandExecution(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "delta.yaml")
+	assert.NoError(t, os.WriteFile(file, []byte(deltaYAML), 0o600))
+
+	testCases := []struct {
+		name           string
+		flags          map[string]string
+		input          string
+		expectedOutput string
+		expectedDelta  *humanitec.Delta
+		expectedErr    int
+	}{
+		{
+			name:           "from file",
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.FilenameFlagName: file},
+			expectedOutput: "ID\tNAME\tENV\tADDED\tUPDATED\tREMOVED\n--\t----\t---\t-----\t-------\t-------\ndelta-development\tUpdate api\tdevelopment\t<none>\tapi\t<none>\n",
+			expectedDelta: &humanitec.Delta{
+				Metadata: humanitec.DeltaMetadata{EnvID: "development", Name: "Update api"},
+				Modules: humanitec.DeltaModules{Update: map[string][]interface{}{"api": {
+					map[string]interface{}{"op": "replace", "path": "/spec/containers/api/image", "value": "api:1.1"},
+				}}},
+			},
+		},
+		{
+			name:           "json from stdin with overrides",
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.FilenameFlagName: "-", constants.EnvFlagName: "staging", constants.NameFlagName: "Cleanup", constants.OutputFlagName: "ndjson"},
+			input:          `{"metadata": {"env_id": "development"}, "modules": {"remove": ["legacy"]}}`,
+			expectedOutput: "{\"id\":\"delta-staging\",\"metadata\":{\"env_id\":\"staging\",\"name\":\"Cleanup\"},\"modules\":{\"remove\":[\"legacy\"]}}\n",
+			expectedDelta: &humanitec.Delta{
+				Metadata: humanitec.DeltaMetadata{EnvID: "staging", Name: "Cleanup"},
+				Modules:  humanitec.DeltaModules{Remove: []string{"legacy"}},
+			},
+		},
+		{
+			name:           "dry run",
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.FilenameFlagName: "-", constants.DryRunFlagName: "true", constants.OrgFlagName: "test-org"},
+			input:          "modules:\n  remove: [legacy]\n",
+			expectedOutput: "DRY RUN: POST /orgs/test-org/apps/test-app/deltas\n{\n  \"metadata\": {},\n  \"modules\": {\n    \"remove\": [\n      \"legacy\"\n    ]\n  }\n}\n",
+		},
+		{
+			name:        "unknown field",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.FilenameFlagName: "-"},
+			input:       "modules:\n  delete: [legacy]\n",
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "invalid workload id",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.FilenameFlagName: "-"},
+			input:       "modules:\n  add:\n    API_Server: {}\n",
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "empty delta",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.FilenameFlagName: "-"},
+			input:       "metadata:\n  name: Nothing\n",
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "invalid env",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.FilenameFlagName: file, constants.EnvFlagName: "Dev_1"},
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "missing file",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.FilenameFlagName: filepath.Join(t.TempDir(), "missing.yaml")},
+			expectedErr: clierrors.ExitValidation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &test.MockClient{}
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommandWithInput(t, create, create, nil, tt.flags, tt.input)
+			if tt.expectedErr != 0 {
+				assert.Equal(t, tt.expectedErr, clierrors.ExitCode(err), "unexpected error: %v", err)
+				assert.Empty(t, mockClient.CreatedDeltas)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, got)
+			if tt.expectedDelta != nil {
+				assert.Equal(t, []humanitec.Delta{*tt.expectedDelta}, mockClient.CreatedDeltas)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package deltas
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// GetCommand returns the command for getting deltas
+func GetCommand() *cobra.Command {
+	return get
+}
+
+// DescribeCommand returns the command for describing a delta
+func DescribeCommand() *cobra.Command {
+	return describe
+}
+
+// CreateCommand returns the command for creating a delta
+func CreateCommand() *cobra.Command {
+	return create
+}
+
+// PatchCommand returns the command for patching a delta
+func PatchCommand() *cobra.Command {
+	return patch
+}
+
+// ArchiveCommand returns the command for archiving a delta
+func ArchiveCommand() *cobra.Command {
+	return archive
+}
+
+// DeployCommand returns the command for deploying a delta
+func DeployCommand() *cobra.Command {
+	return deploy
+}
@@ -0,0 +1,83 @@
+package deltas
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/deployments"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for deploying deltas
+	deploy = &cobra.Command{
+		Use:   constants.DeltaCmdUse + " <id>",
+		Short: constants.DeltaCmdShort,
+		Long: `Deploy a delta to an environment.
+The delta is deployed to the environment it is intended for unless --env is given. With --wait
+the status of the deployment is printed to standard error as it changes until the deployment
+has finished; the command fails if the deployment fails, or with exit code 7 if it does not
+finish within --timeout.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := appID(cmd)
+			if err != nil {
+				return err
+			}
+
+			env, err := envID(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			id := args[0]
+			delta, err := client.GetDelta(app, id)
+			if err != nil {
+				return fmt.Errorf("failed to get delta: %w", err)
+			}
+			if env == "" {
+				env = delta.Metadata.EnvID
+			}
+			if env == "" {
+				return clierrors.Validationf("delta %s is not intended for an environment, use --%s", id, constants.EnvFlagName)
+			}
+
+			return deployments.Deploy(cmd, client, app, env, humanitec.DeploymentRequest{DeltaID: id}, outputFormat)
+		},
+	}
+)
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(deploy)
+	deployments.DeployFlagSet()(deploy)
+
+	// Add command-specific flags
+	deploy.Flags().StringP(constants.EnvFlagName, constants.EnvFlagShort, "", constants.DeployDeltaEnvFlagHelp)
+}
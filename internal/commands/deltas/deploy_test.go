@@ -0,0 +1,96 @@
+package deltas
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeployDeltaCommandExecution verifies that deploy delta deploys a delta to the environment
+// it is intended for or to the environment given with --env.
+func TestDeployDeltaCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name            string
+		args            []string
+		flags           map[string]string
+		expectedOutput  string
+		expectedRequest humanitec.DeploymentRequest
+		expectedErr     int
+	}{
+		{
+			name:            "intended environment",
+			args:            []string{"delta-1"},
+			flags:           map[string]string{constants.AppFlagName: "test-app", constants.OutputFlagName: "ndjson"},
+			expectedOutput:  "{\"id\":\"deploy-development\",\"env_id\":\"development\",\"set_id\":\"\",\"delta_id\":\"delta-1\",\"status\":\"pending\"}\n",
+			expectedRequest: humanitec.DeploymentRequest{DeltaID: "delta-1"},
+		},
+		{
+			name:            "other environment with comment",
+			args:            []string{"delta-1"},
+			flags:           map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "qa", constants.CommentFlagName: "Try worker", constants.OutputFlagName: "ndjson"},
+			expectedOutput:  "{\"id\":\"deploy-qa\",\"env_id\":\"qa\",\"set_id\":\"\",\"delta_id\":\"delta-1\",\"comment\":\"Try worker\",\"status\":\"pending\"}\n",
+			expectedRequest: humanitec.DeploymentRequest{DeltaID: "delta-1", Comment: "Try worker"},
+		},
+		{
+			name:        "delta not found",
+			args:        []string{"delta-9"},
+			flags:       map[string]string{constants.AppFlagName: "test-app"},
+			expectedErr: clierrors.ExitNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &test.MockClient{
+				Deltas: []humanitec.Delta{
+					{
+						ID:       "delta-1",
+						Metadata: humanitec.DeltaMetadata{EnvID: "development", Name: "Add worker", CreatedAt: "2024-01-01T00:00:00Z", CreatedBy: "user-1"},
+						Modules: humanitec.DeltaModules{
+							Add: map[string]interface{}{"worker": map[string]interface{}{"profile": "default-module"}},
+							Update: map[string][]interface{}{"api": {
+								map[string]interface{}{"op": "replace", "path": "/spec/containers/api/image", "value": "api:1.1"},
+							}},
+						},
+					},
+					{
+						ID:       "delta-2",
+						Metadata: humanitec.DeltaMetadata{EnvID: "staging"},
+						Modules:  humanitec.DeltaModules{Remove: []string{"legacy"}},
+						Shared:   []interface{}{map[string]interface{}{"op": "remove", "path": "/dns"}},
+					},
+					{
+						ID:       "delta-3",
+						Metadata: humanitec.DeltaMetadata{EnvID: "development", Archived: true},
+						Modules:  humanitec.DeltaModules{Remove: []string{"api"}},
+					},
+				},
+			}
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommand(t, deploy, deploy, tt.args, tt.flags)
+			if tt.expectedErr != 0 {
+				assert.Equal(t, tt.expectedErr, clierrors.ExitCode(err), "unexpected error: %v", err)
+				assert.Empty(t, mockClient.CreatedDeployments)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, got)
+			assert.Equal(t, []humanitec.DeploymentRequest{tt.expectedRequest}, mockClient.CreatedDeployments)
+		})
+	}
+}
+
+// TestDeployDeltaWithoutEnvironment verifies that a delta without an environment needs --env.
+func TestDeployDeltaWithoutEnvironment(t *testing.T) {
+	mockClient := &test.MockClient{Deltas: []humanitec.Delta{{ID: "delta-1"}}}
+	test.SetupMockClient(t, mockClient)
+
+	_, err := test.ExecuteCommand(t, deploy, deploy, []string{"delta-1"}, map[string]string{constants.AppFlagName: "test-app"})
+	assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(err))
+	assert.Empty(t, mockClient.CreatedDeployments)
+}
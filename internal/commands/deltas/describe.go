@@ -0,0 +1,70 @@
+package deltas
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for describing a delta
+	describe = &cobra.Command{
+		Use:   constants.DeltaCmdUse + " <id>",
+		Short: constants.DeltaCmdShort,
+		Long: `Show a detailed summary of a delta.
+The workloads added by the delta are shown in full, followed by the patch operations on
+updated workloads and shared resources and the workloads it removes.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := appID(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			delta, err := client.GetDelta(app, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get delta: %w", err)
+			}
+
+			// Print output
+			formatted, err := output.FormatDeltaDescription(delta, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(describe)
+}
@@ -0,0 +1,106 @@
+package deltas
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDescribeDeltaCommandExecution verifies that describe delta renders the changes of a delta.
+func TestDescribeDeltaCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		expectedOutput string
+		expectError    bool
+	}{
+		{
+			name:  "added and updated workloads",
+			args:  []string{"delta-1"},
+			flags: map[string]string{constants.AppFlagName: "test-app"},
+			expectedOutput: "ID:                delta-1\n" +
+				"Name:              Add worker\n" +
+				"Environment:       development\n" +
+				"Archived:          false\n" +
+				"Created At:        2024-01-01T00:00:00Z\n" +
+				"Created By:        user-1\n" +
+				"Last Modified At:  <none>\n" +
+				"\nAdded workloads:\n  + worker\n    profile: default-module\n" +
+				"\nUpdated workloads:\n  ~ api\n    replace /spec/containers/api/image \"api:1.1\"\n" +
+				"\nRemoved workloads:\n  <none>\n" +
+				"\nShared resources:\n  <none>\n",
+		},
+		{
+			name:  "removed workloads and shared resources",
+			args:  []string{"delta-2"},
+			flags: map[string]string{constants.AppFlagName: "test-app"},
+			expectedOutput: "ID:                delta-2\n" +
+				"Name:              <none>\n" +
+				"Environment:       staging\n" +
+				"Archived:          false\n" +
+				"Created At:        <none>\n" +
+				"Created By:        <none>\n" +
+				"Last Modified At:  <none>\n" +
+				"\nAdded workloads:\n  <none>\n" +
+				"\nUpdated workloads:\n  <none>\n" +
+				"\nRemoved workloads:\n  - legacy\n" +
+				"\nShared resources:\n  remove /dns\n",
+		},
+		{
+			name:           "json format",
+			args:           []string{"delta-3"},
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"id\":\"delta-3\",\"metadata\":{\"env_id\":\"development\",\"archived\":true},\"modules\":{\"remove\":[\"api\"]}}\n",
+		},
+		{
+			name:        "delta not found",
+			args:        []string{"delta-9"},
+			flags:       map[string]string{constants.AppFlagName: "test-app"},
+			expectError: true,
+		},
+	}
+
+	mockClient := &test.MockClient{
+		Deltas: []humanitec.Delta{
+			{
+				ID:       "delta-1",
+				Metadata: humanitec.DeltaMetadata{EnvID: "development", Name: "Add worker", CreatedAt: "2024-01-01T00:00:00Z", CreatedBy: "user-1"},
+				Modules: humanitec.DeltaModules{
+					Add: map[string]interface{}{"worker": map[string]interface{}{"profile": "default-module"}},
+					Update: map[string][]interface{}{"api": {
+						map[string]interface{}{"op": "replace", "path": "/spec/containers/api/image", "value": "api:1.1"},
+					}},
+				},
+			},
+			{
+				ID:       "delta-2",
+				Metadata: humanitec.DeltaMetadata{EnvID: "staging"},
+				Modules:  humanitec.DeltaModules{Remove: []string{"legacy"}},
+				Shared:   []interface{}{map[string]interface{}{"op": "remove", "path": "/dns"}},
+			},
+			{
+				ID:       "delta-3",
+				Metadata: humanitec.DeltaMetadata{EnvID: "development", Archived: true},
+				Modules:  humanitec.DeltaModules{Remove: []string{"api"}},
+			},
+		},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(t, describe, describe, tt.args, tt.flags)
+			if (err != nil) != tt.expectError {
+				t.Errorf("describe.Execute() error = %v, wantErr %v", err, tt.expectError)
+				return
+			}
+			if !tt.expectError {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
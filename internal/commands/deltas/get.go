@@ -0,0 +1,136 @@
+package deltas
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for getting deltas
+	get = &cobra.Command{
+		Use:     constants.DeltasCmdUse + " [id]",
+		Aliases: []string{constants.DeltaCmdUse},
+		Short:   constants.DeltasCmdShort,
+		Long: `List the active deltas of an application, or get a single delta by ID.
+Table output shows the workloads each delta adds, updates and removes. Use --env to only list
+the deltas intended for an environment and --archived to list archived deltas instead.
+With --watch the list is refreshed every --interval and changes are printed as ADDED,
+MODIFIED and DELETED events until interrupted. When writing a table to a terminal, the
+whole table is redrawn instead.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := appID(cmd)
+			if err != nil {
+				return err
+			}
+
+			env, err := envID(cmd)
+			if err != nil {
+				return err
+			}
+
+			archived, err := cmd.Flags().GetBool(constants.ArchivedFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get archived flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			watchChanges, interval, err := apps.WatchSettings(cmd)
+			if err != nil {
+				return err
+			}
+			if watchChanges && len(args) == 1 {
+				return clierrors.Validationf("--%s is only supported when listing all deltas", constants.WatchFlagName)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			if watchChanges {
+				return watchDeltas(cmd, client, app, env, archived, outputFormat, interval)
+			}
+
+			// If ID is provided, get single delta
+			if len(args) == 1 {
+				delta, err := client.GetDelta(app, args[0])
+				if err != nil {
+					return fmt.Errorf("failed to get delta: %w", err)
+				}
+
+				// Print output
+				formatted, err := output.FormatDelta(delta, outputFormat)
+				if err != nil {
+					return fmt.Errorf("failed to format output: %w", err)
+				}
+				fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+				return nil
+			}
+
+			// Otherwise, list the deltas of the application
+			deltas, err := client.GetDeltas(app, env, archived)
+			if err != nil {
+				return fmt.Errorf("failed to list deltas: %w", err)
+			}
+
+			// Print output
+			formatted, err := output.FormatDeltas(deltas, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+// watchDeltas prints the deltas of an application and then the changes to them until the command
+// is interrupted. Deltas that are archived, or restored with --archived, are printed as deleted.
+func watchDeltas(cmd *cobra.Command, client humanitec.Client, app, env string, archived bool, outputFormat output.Format, interval time.Duration) error {
+	list := func() ([]humanitec.Delta, error) {
+		return client.GetDeltas(app, env, archived)
+	}
+
+	err := output.Watch(cmd.Context(), cmd.OutOrStdout(), interval, list,
+		func(delta humanitec.Delta) string { return delta.ID }, output.FormatDeltas, outputFormat)
+	if err != nil {
+		return fmt.Errorf("failed to list deltas: %w", err)
+	}
+	return nil
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(get)
+	apps.WatchFlagSet()(get)
+
+	// Add command-specific flags
+	get.Flags().StringP(constants.EnvFlagName, constants.EnvFlagShort, "", constants.DeltaEnvFilterFlagHelp)
+	get.Flags().Bool(constants.ArchivedFlagName, false, constants.ArchivedFlagHelp)
+}
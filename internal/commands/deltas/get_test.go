@@ -0,0 +1,153 @@
+package deltas
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGetDeltaCommandExecution verifies that the get deltas command lists the deltas of an
+// application or gets a single delta, in every output format.
+func TestGetDeltaCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		expectedOutput string
+		expectError    bool
+	}{
+		{
+			name:  "list deltas - table format",
+			flags: map[string]string{constants.AppFlagName: "test-app"},
+			expectedOutput: "ID\tNAME\tENV\tADDED\tUPDATED\tREMOVED\n--\t----\t---\t-----\t-------\t-------\n" +
+				"delta-1\tAdd worker\tdevelopment\tworker\tapi\t<none>\n" +
+				"delta-2\t<none>\tstaging\t<none>\t<none>\tlegacy\n",
+		},
+		{
+			name:  "list deltas of env - wide format",
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development", constants.OutputFlagName: "wide"},
+			expectedOutput: "ID\tNAME\tENV\tADDED\tUPDATED\tREMOVED\tSHARED\tCREATED AT\tCREATED BY\tLAST MODIFIED AT\tARCHIVED\n" +
+				"--\t----\t---\t-----\t-------\t-------\t------\t----------\t----------\t----------------\t--------\n" +
+				"delta-1\tAdd worker\tdevelopment\tworker\tapi\t<none>\t0\t2024-01-01T00:00:00Z\tuser-1\t<none>\tfalse\n",
+		},
+		{
+			name:           "list archived deltas - ndjson format",
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.ArchivedFlagName: "true", constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"id\":\"delta-3\",\"metadata\":{\"env_id\":\"development\",\"archived\":true},\"modules\":{\"remove\":[\"api\"]}}\n",
+		},
+		{
+			name:           "get single delta - yaml format",
+			args:           []string{"delta-2"},
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.OutputFlagName: "yaml"},
+			expectedOutput: "apiVersion: humctl-wrapper/v1\nkind: Delta\nitem:\n    id: delta-2\n    metadata:\n        env_id: staging\n    modules:\n        remove:\n            - legacy\n    shared:\n        - op: remove\n          path: /dns\n",
+		},
+		{
+			name:        "delta not found",
+			args:        []string{"delta-9"},
+			flags:       map[string]string{constants.AppFlagName: "test-app"},
+			expectError: true,
+		},
+		{
+			name:        "invalid env id",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "Dev_1"},
+			expectError: true,
+		},
+	}
+
+	mockClient := &test.MockClient{
+		Deltas: []humanitec.Delta{
+			{
+				ID:       "delta-1",
+				Metadata: humanitec.DeltaMetadata{EnvID: "development", Name: "Add worker", CreatedAt: "2024-01-01T00:00:00Z", CreatedBy: "user-1"},
+				Modules: humanitec.DeltaModules{
+					Add: map[string]interface{}{"worker": map[string]interface{}{"profile": "default-module"}},
+					Update: map[string][]interface{}{"api": {
+						map[string]interface{}{"op": "replace", "path": "/spec/containers/api/image", "value": "api:1.1"},
+					}},
+				},
+			},
+			{
+				ID:       "delta-2",
+				Metadata: humanitec.DeltaMetadata{EnvID: "staging"},
+				Modules:  humanitec.DeltaModules{Remove: []string{"legacy"}},
+				Shared:   []interface{}{map[string]interface{}{"op": "remove", "path": "/dns"}},
+			},
+			{
+				ID:       "delta-3",
+				Metadata: humanitec.DeltaMetadata{EnvID: "development", Archived: true},
+				Modules:  humanitec.DeltaModules{Remove: []string{"api"}},
+			},
+		},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(t, get, get, tt.args, tt.flags)
+			if (err != nil) != tt.expectError {
+				t.Errorf("get.Execute() error = %v, wantErr %v", err, tt.expectError)
+				return
+			}
+			if !tt.expectError {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
+
+// TestGetDeltasWatch verifies that --watch prints the initial deltas as ADDED events followed
+// by the changes between listings, and that archived deltas are printed as DELETED.
+func TestGetDeltasWatch(t *testing.T) {
+	delta1 := humanitec.Delta{
+		ID:       "delta-1",
+		Metadata: humanitec.DeltaMetadata{Name: "Add worker", EnvID: "development"},
+		Modules:  humanitec.DeltaModules{Add: map[string]interface{}{"worker": map[string]interface{}{}}},
+	}
+	updated := delta1
+	updated.Modules = humanitec.DeltaModules{Add: map[string]interface{}{"worker": map[string]interface{}{}}, Remove: []string{"legacy"}}
+	archived := updated
+	archived.Metadata.Archived = true
+
+	test.SetupMockClient(t, &test.MockClient{DeltaLists: [][]humanitec.Delta{
+		{delta1},
+		{updated},
+		{archived},
+	}})
+
+	// Watching runs until interrupted, so stop it once all lists have been seen
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	got, err := test.ExecuteCommandContext(t, ctx, get, get, nil, map[string]string{
+		constants.AppFlagName:      "test-app",
+		constants.WatchFlagName:    "true",
+		constants.IntervalFlagName: "1ms",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "EVENT\tID\tNAME\tENV\tADDED\tUPDATED\tREMOVED\n-----\t--\t----\t---\t-----\t-------\t-------\n"+
+		"ADDED\tdelta-1\tAdd worker\tdevelopment\tworker\t<none>\t<none>\n"+
+		"MODIFIED\tdelta-1\tAdd worker\tdevelopment\tworker\t<none>\tlegacy\n"+
+		"DELETED\tdelta-1\tAdd worker\tdevelopment\tworker\t<none>\tlegacy\n", got)
+
+	// Watching a single delta is not supported
+	_, err = test.ExecuteCommand(t, get, get, []string{"delta-1"}, map[string]string{constants.AppFlagName: "test-app", constants.WatchFlagName: "true"})
+	assert.Error(t, err)
+}
+
+// TestGetDeltaCommandConfiguration verifies that the get deltas command is properly configured
+// with the correct name, description, and flags.
+func TestGetDeltaCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.DeltasCmdUse, get.Name(), "get command should have correct use")
+	assert.Equal(t, constants.DeltasCmdShort, get.Short, "get command should have correct short description")
+
+	assert.True(t, get.Flags().Lookup(constants.AppFlagName) != nil, "get command should have app flag")
+	assert.True(t, get.Flags().Lookup(constants.EnvFlagName) != nil, "get command should have env flag")
+	assert.True(t, get.Flags().Lookup(constants.ArchivedFlagName) != nil, "get command should have archived flag")
+	assert.True(t, get.Flags().Lookup(constants.WatchFlagName) != nil, "get command should have watch flag")
+}
@@ -0,0 +1,217 @@
+package deltas
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/diffutil"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Paths below which patch operations may change a delta
+var patchablePaths = []string{"/modules/add", "/modules/remove", "/modules/update", "/shared"}
+
+var (
+	// Subcommand for patching deltas
+	patch = &cobra.Command{
+		Use:   constants.DeltaCmdUse + " <id>",
+		Short: constants.DeltaCmdShort,
+		Long: `Change a delta with JSON Patch (RFC 6902) operations.
+The operations are given inline with --patch or read from a file with -f, in JSON or YAML, and
+are applied to the modules and shared resources of the delta, e.g.
+
+  [{"op": "add", "path": "/modules/update/api", "value": [
+     {"op": "replace", "path": "/spec/containers/api/image", "value": "api:1.1"}]},
+   {"op": "add", "path": "/modules/remove/-", "value": "worker"}]
+
+The add, remove and replace operations are supported. The patched delta replaces the current
+one; use --dry-run to print the request without sending it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := appID(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Get flags
+			patchText, err := cmd.Flags().GetString(constants.PatchFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get patch flag: %w", err)
+			}
+
+			filename, err := cmd.Flags().GetString(constants.FilenameFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get filename flag: %w", err)
+			}
+
+			dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Read and validate the operations before calling the API
+			if (patchText == "") == (filename == "") {
+				return clierrors.Validationf("exactly one of --%s and --%s is required", constants.PatchFlagName, constants.FilenameFlagName)
+			}
+			data, source := []byte(patchText), "--"+constants.PatchFlagName
+			if filename != "" {
+				if data, err = readFile(filename, cmd.InOrStdin()); err != nil {
+					return err
+				}
+				source = filename
+			}
+			ops, err := decodePatch(data, source)
+			if err != nil {
+				return err
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			id := args[0]
+			delta, err := client.GetDelta(app, id)
+			if err != nil {
+				return fmt.Errorf("failed to get delta: %w", err)
+			}
+			if err := applyPatch(delta, ops); err != nil {
+				return err
+			}
+
+			// Print the request instead of sending it on a dry run
+			if dryRun {
+				return apps.PrintRequests(cmd, []humanitec.Request{humanitec.UpdateDeltaRequest(org, app, id, *delta)}, outputFormat)
+			}
+
+			updated, err := client.UpdateDelta(app, id, *delta)
+			if err != nil {
+				return fmt.Errorf("failed to update delta: %w", err)
+			}
+
+			// Print output
+			formatted, err := output.FormatDelta(updated, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+// decodePatch parses JSON Patch operations in JSON or YAML and checks that they only
+// change the modules and shared resources of a delta
+func decodePatch(data []byte, source string) ([]diffutil.PatchOp, error) {
+	var ops []diffutil.PatchOp
+	if err := yaml.Unmarshal(data, &ops); err != nil {
+		return nil, clierrors.Validationf("failed to parse patch %s: %v", source, err)
+	}
+	if len(ops) == 0 {
+		return nil, clierrors.Validationf("patch %s has no operations", source)
+	}
+
+	for i, op := range ops {
+		switch op.Op {
+		case diffutil.OpAdd, diffutil.OpRemove, diffutil.OpReplace:
+		default:
+			return nil, clierrors.Validationf("operation %d of patch %s: unsupported op %q, must be one of %s, %s, %s",
+				i, source, op.Op, diffutil.OpAdd, diffutil.OpRemove, diffutil.OpReplace)
+		}
+		if !patchable(op.Path) {
+			return nil, clierrors.Validationf("operation %d of patch %s: path %q must be below one of %s",
+				i, source, op.Path, strings.Join(patchablePaths, ", "))
+		}
+	}
+	return ops, nil
+}
+
+// patchable reports whether path is one of the patchable paths or below one of them
+func patchable(path string) bool {
+	for _, prefix := range patchablePaths {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// applyPatch applies operations to the modules and shared resources of a delta. Missing
+// sections are treated as empty so that operations can add the first entry to them. The
+// workload IDs of the patched modules are validated.
+func applyPatch(delta *humanitec.Delta, ops []diffutil.PatchOp) error {
+	doc := map[string]interface{}{
+		"modules": map[string]interface{}{
+			"add":    orEmpty(delta.Modules.Add),
+			"remove": append([]string{}, delta.Modules.Remove...),
+			"update": orEmpty(delta.Modules.Update),
+		},
+		"shared": append([]interface{}{}, delta.Shared...),
+	}
+
+	patched, err := diffutil.Apply(doc, ops)
+	if err != nil {
+		return clierrors.Validationf("failed to apply patch: %v", err)
+	}
+
+	// Decode the patched sections back into the delta
+	data, err := json.Marshal(patched)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON: %w", err)
+	}
+	var sections struct {
+		Modules humanitec.DeltaModules `json:"modules"`
+		Shared  []interface{}          `json:"shared"`
+	}
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return clierrors.Validationf("patched delta is invalid: %v", err)
+	}
+	if err := validateWorkloads(sections.Modules); err != nil {
+		return err
+	}
+	delta.Modules, delta.Shared = sections.Modules, sections.Shared
+	return nil
+}
+
+// orEmpty returns m, or an empty map if m is nil
+func orEmpty[T any](m map[string]T) map[string]T {
+	if m == nil {
+		return map[string]T{}
+	}
+	return m
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(patch)
+
+	// Add command-specific flags
+	patch.Flags().StringP(constants.PatchFlagName, constants.PatchFlagShort, "", constants.PatchFlagHelp)
+	patch.Flags().StringP(constants.FilenameFlagName, constants.FilenameFlagShort, "", constants.PatchFileFlagHelp)
+	patch.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
+}
@@ -0,0 +1,138 @@
+package deltas
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPatchDeltaCommandExecution verifies that patch delta applies JSON Patch operations to the
+// modules and shared resources of a delta.
+func TestPatchDeltaCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		input          string
+		expectedOutput string
+		expectedDelta  *humanitec.Delta
+		expectedErr    int
+	}{
+		{
+			name: "add to missing sections",
+			args: []string{"delta-2"},
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.OutputFlagName: "ndjson", constants.PatchFlagName: `[
+				{"op": "add", "path": "/modules/update/api", "value": [{"op": "replace", "path": "/spec/replicas", "value": 2}]},
+				{"op": "add", "path": "/modules/remove/-", "value": "cron"},
+				{"op": "remove", "path": "/shared/0"}]`},
+			expectedOutput: "{\"id\":\"delta-2\",\"metadata\":{\"env_id\":\"staging\"},\"modules\":{\"remove\":[\"legacy\",\"cron\"],\"update\":{\"api\":[{\"op\":\"replace\",\"path\":\"/spec/replicas\",\"value\":2}]}}}\n",
+			expectedDelta: &humanitec.Delta{
+				Metadata: humanitec.DeltaMetadata{EnvID: "staging"},
+				Modules: humanitec.DeltaModules{
+					Add:    map[string]interface{}{},
+					Remove: []string{"legacy", "cron"},
+					Update: map[string][]interface{}{"api": {map[string]interface{}{"op": "replace", "path": "/spec/replicas", "value": float64(2)}}},
+				},
+				Shared: []interface{}{},
+			},
+		},
+		{
+			name:  "yaml from stdin",
+			args:  []string{"delta-1"},
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.FilenameFlagName: "-", constants.OutputFlagName: "ndjson"},
+			input: "- op: remove\n  path: /modules/add/worker\n- op: replace\n  path: /modules/update/api/0/value\n  value: api:1.2\n",
+			expectedOutput: "{\"id\":\"delta-1\",\"metadata\":{\"env_id\":\"development\",\"name\":\"Add worker\",\"created_at\":\"2024-01-01T00:00:00Z\",\"created_by\":\"user-1\"}," +
+				"\"modules\":{\"update\":{\"api\":[{\"op\":\"replace\",\"path\":\"/spec/containers/api/image\",\"value\":\"api:1.2\"}]}}}\n",
+		},
+		{
+			name:           "dry run",
+			args:           []string{"delta-2"},
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.PatchFlagName: `[{"op": "remove", "path": "/modules/remove/0"}]`, constants.DryRunFlagName: "true", constants.OrgFlagName: "test-org"},
+			expectedOutput: "DRY RUN: PUT /orgs/test-org/apps/test-app/deltas/delta-2\n{\n  \"id\": \"delta-2\",\n  \"metadata\": {\n    \"env_id\": \"staging\"\n  },\n  \"modules\": {},\n  \"shared\": [\n    {\n      \"op\": \"remove\",\n      \"path\": \"/dns\"\n    }\n  ]\n}\n",
+		},
+		{
+			name:        "path outside modules and shared",
+			args:        []string{"delta-1"},
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.PatchFlagName: `[{"op": "replace", "path": "/metadata/env_id", "value": "production"}]`},
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "unsupported op",
+			args:        []string{"delta-1"},
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.PatchFlagName: `[{"op": "move", "from": "/modules/add/worker", "path": "/modules/add/cron"}]`},
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "missing path",
+			args:        []string{"delta-1"},
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.PatchFlagName: `[{"op": "remove", "path": "/modules/add/cron"}]`},
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "invalid workload id",
+			args:        []string{"delta-1"},
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.PatchFlagName: `[{"op": "add", "path": "/modules/remove/-", "value": "Legacy_Worker"}]`},
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "neither patch nor file",
+			args:        []string{"delta-1"},
+			flags:       map[string]string{constants.AppFlagName: "test-app"},
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "delta not found",
+			args:        []string{"delta-9"},
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.PatchFlagName: `[{"op": "remove", "path": "/shared/0"}]`},
+			expectedErr: clierrors.ExitNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &test.MockClient{
+				Deltas: []humanitec.Delta{
+					{
+						ID:       "delta-1",
+						Metadata: humanitec.DeltaMetadata{EnvID: "development", Name: "Add worker", CreatedAt: "2024-01-01T00:00:00Z", CreatedBy: "user-1"},
+						Modules: humanitec.DeltaModules{
+							Add: map[string]interface{}{"worker": map[string]interface{}{"profile": "default-module"}},
+							Update: map[string][]interface{}{"api": {
+								map[string]interface{}{"op": "replace", "path": "/spec/containers/api/image", "value": "api:1.1"},
+							}},
+						},
+					},
+					{
+						ID:       "delta-2",
+						Metadata: humanitec.DeltaMetadata{EnvID: "staging"},
+						Modules:  humanitec.DeltaModules{Remove: []string{"legacy"}},
+						Shared:   []interface{}{map[string]interface{}{"op": "remove", "path": "/dns"}},
+					},
+					{
+						ID:       "delta-3",
+						Metadata: humanitec.DeltaMetadata{EnvID: "development", Archived: true},
+						Modules:  humanitec.DeltaModules{Remove: []string{"api"}},
+					},
+				},
+			}
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommandWithInput(t, patch, patch, tt.args, tt.flags, tt.input)
+			if tt.expectedErr != 0 {
+				assert.Equal(t, tt.expectedErr, clierrors.ExitCode(err), "unexpected error: %v", err)
+				assert.Empty(t, mockClient.UpdatedDeltas)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, got)
+			if tt.expectedDelta != nil {
+				tt.expectedDelta.ID = tt.args[0]
+				assert.Equal(t, []humanitec.Delta{*tt.expectedDelta}, mockClient.UpdatedDeltas)
+			}
+		})
+	}
+}
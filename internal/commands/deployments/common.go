@@ -0,0 +1,105 @@
+package deployments
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/poll"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+// CommonFlagSet returns a function that adds the common flags and the required
+// --app and --env flags to a command
+func CommonFlagSet() func(*cobra.Command) {
+	return func(cmd *cobra.Command) {
+		apps.CommonFlagSet()(cmd)
+		cmd.Flags().StringP(constants.AppFlagName, constants.AppFlagShort, "", constants.AppIDFlagHelp)
+		cmd.Flags().StringP(constants.EnvFlagName, constants.EnvFlagShort, "", constants.EnvFlagHelp)
+		cmd.MarkFlagRequired(constants.AppFlagName)
+		cmd.MarkFlagRequired(constants.EnvFlagName)
+	}
+}
+
+// appEnv returns the validated application and environment IDs given with --app and --env
+func appEnv(cmd *cobra.Command) (app, env string, err error) {
+	app, err = cmd.Flags().GetString(constants.AppFlagName)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get app flag: %w", err)
+	}
+	env, err = cmd.Flags().GetString(constants.EnvFlagName)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get env flag: %w", err)
+	}
+	if err := validation.ID(validation.App, app); err != nil {
+		return "", "", err
+	}
+	if err := validation.ID(validation.Environment, env); err != nil {
+		return "", "", err
+	}
+	return app, env, nil
+}
+
+// waitForDeployment polls a deployment until done reports true, printing every status
+// change to w. It returns the last state of the deployment.
+func waitForDeployment(client humanitec.Client, app, env, id string, timeout, interval time.Duration, w io.Writer,
+	done func(*humanitec.Deployment) (bool, error)) (*humanitec.Deployment, error) {
+	var deployment *humanitec.Deployment
+	status := ""
+	err := poll.Until(timeout, interval, func() (bool, error) {
+		var err error
+		if deployment, err = client.GetDeployment(app, env, id); err != nil {
+			return false, err
+		}
+		if deployment.Status != status {
+			status = deployment.Status
+			fmt.Fprintf(w, "deployment %s: %s\n", id, status)
+		}
+		return done(deployment)
+	})
+	return deployment, err
+}
+
+// printErrorSummary prints the errors reported by a failed deployment to w
+func printErrorSummary(w io.Writer, client humanitec.Client, app, env, id string) error {
+	deploymentErrors, err := client.GetDeploymentErrors(app, env, id)
+	if err != nil {
+		return fmt.Errorf("failed to get deployment errors: %w", err)
+	}
+	fmt.Fprint(w, output.FormatDeploymentErrorSummary(id, deploymentErrors))
+	return nil
+}
+
+// setDiff returns the differences between the workloads and shared resources of two
+// deployment sets of an application, or "" if they are equal
+func setDiff(client humanitec.Client, app, fromID, toID string) (string, error) {
+	if fromID == toID {
+		return "", nil
+	}
+	from, err := getSet(client, app, fromID)
+	if err != nil {
+		return "", err
+	}
+	to, err := getSet(client, app, toID)
+	if err != nil {
+		return "", err
+	}
+	return output.FormatSetDiff(from, to, "set/"+fromID, "set/"+toID, output.FormatTable)
+}
+
+// getSet returns a deployment set of an application, or nil if id is empty
+func getSet(client humanitec.Client, app, id string) (*humanitec.DeploymentSet, error) {
+	if id == "" {
+		return nil, nil
+	}
+	set, err := client.GetSet(app, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment set %s: %w", id, err)
+	}
+	return set, nil
+}
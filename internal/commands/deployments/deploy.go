@@ -0,0 +1,145 @@
+package deployments
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command for starting deployments
+	deploy = &cobra.Command{
+		Use:   constants.DeployCmdUse,
+		Short: constants.DeployCmdShort,
+		Long: `Deploy a delta or a deployment set to an environment.
+Exactly one of --delta and --set must be given. With --wait the status of the deployment is
+printed to standard error as it changes until the deployment has finished; the command fails
+if the deployment fails, or with exit code 7 if it does not finish within --timeout. The
+errors of a failed deployment are summarized on standard error.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, env, err := appEnv(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Get flags
+			deltaID, err := cmd.Flags().GetString(constants.DeltaFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get delta flag: %w", err)
+			}
+
+			setID, err := cmd.Flags().GetString(constants.SetFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get set flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Validate input before calling the API
+			if (deltaID == "") == (setID == "") {
+				return clierrors.Validationf("exactly one of --%s and --%s is required", constants.DeltaFlagName, constants.SetFlagName)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			return Deploy(cmd, client, app, env, humanitec.DeploymentRequest{DeltaID: deltaID, SetID: setID}, outputFormat)
+		},
+	}
+)
+
+// DeployFlagSet returns a function that adds the --comment, --wait, --timeout and --interval
+// flags used by Deploy to a command
+func DeployFlagSet() func(*cobra.Command) {
+	return func(cmd *cobra.Command) {
+		apps.WaitFlagSet()(cmd)
+		cmd.Flags().String(constants.CommentFlagName, "", constants.CommentFlagHelp)
+		cmd.Flags().Bool(constants.WaitFlagName, false, constants.WaitFlagHelp)
+	}
+}
+
+// Deploy starts a deployment with the comment given with --comment and prints it. With --wait
+// it prints status changes to standard error until the deployment has finished and fails if
+// the deployment failed, after printing the errors it reported to standard error.
+func Deploy(cmd *cobra.Command, client humanitec.Client, app, env string, req humanitec.DeploymentRequest, outputFormat output.Format) error {
+	comment, err := cmd.Flags().GetString(constants.CommentFlagName)
+	if err != nil {
+		return fmt.Errorf("failed to get comment flag: %w", err)
+	}
+	if comment != "" {
+		req.Comment = comment
+	}
+
+	waitDone, err := cmd.Flags().GetBool(constants.WaitFlagName)
+	if err != nil {
+		return fmt.Errorf("failed to get wait flag: %w", err)
+	}
+
+	timeout, interval, err := apps.WaitTimings(cmd)
+	if err != nil {
+		return err
+	}
+
+	deployment, err := client.CreateDeployment(app, env, req)
+	if err != nil {
+		return fmt.Errorf("failed to deploy to env %s: %w", env, err)
+	}
+
+	if waitDone {
+		id := deployment.ID
+		deployment, err = waitForDeployment(client, app, env, id, timeout, interval, cmd.ErrOrStderr(),
+			func(d *humanitec.Deployment) (bool, error) { return d.IsDone(), nil })
+		if err != nil {
+			return fmt.Errorf("failed waiting for deployment %s: %w", id, err)
+		}
+	}
+
+	// Print output
+	formatted, err := output.FormatDeployment(deployment, outputFormat)
+	if err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+	fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+	if deployment.Status == humanitec.DeploymentFailed {
+		cmd.SilenceUsage = true
+		if err := printErrorSummary(cmd.ErrOrStderr(), client, app, env, deployment.ID); err != nil {
+			return fmt.Errorf("deployment %s to env %s failed (%v)", deployment.ID, env, err)
+		}
+		return fmt.Errorf("deployment %s to env %s failed", deployment.ID, env)
+	}
+	return nil
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(deploy)
+	DeployFlagSet()(deploy)
+
+	// Add command-specific flags
+	deploy.Flags().String(constants.DeltaFlagName, "", constants.DeltaFlagHelp)
+	deploy.Flags().String(constants.SetFlagName, "", constants.SetFlagHelp)
+}
@@ -0,0 +1,132 @@
+package deployments
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeployCommandExecution verifies that deploy starts a deployment from a delta or a set
+// and, with --wait, waits for it to finish.
+func TestDeployCommandExecution(t *testing.T) {
+	flags := func(extra map[string]string) map[string]string {
+		f := map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development"}
+		for k, v := range extra {
+			f[k] = v
+		}
+		return f
+	}
+
+	testCases := []struct {
+		name           string
+		flags          map[string]string
+		mockClient     *test.MockClient
+		expectedOutput string
+		expectedExit   int
+	}{
+		{
+			name:           "deploy delta",
+			flags:          flags(map[string]string{constants.DeltaFlagName: "delta-1", constants.CommentFlagName: "Add worker"}),
+			mockClient:     &test.MockClient{},
+			expectedOutput: "ID\tSTATUS\tSET\tCREATED AT\tCOMMENT\n--\t------\t---\t----------\t-------\ndeploy-development\tpending\t\t<none>\tAdd worker\n",
+		},
+		{
+			name: "deploy set and wait",
+			flags: flags(map[string]string{constants.SetFlagName: "set-1", constants.OutputFlagName: "ndjson",
+				constants.WaitFlagName: "true", constants.IntervalFlagName: "1ms", constants.TimeoutFlagName: "1s"}),
+			mockClient: &test.MockClient{
+				Deployments:        []humanitec.Deployment{{ID: "deploy-development", EnvID: "development", SetID: "set-1"}},
+				DeploymentStatuses: []string{"pending", "in progress", "succeeded"},
+			},
+			expectedOutput: "{\"id\":\"deploy-development\",\"env_id\":\"development\",\"set_id\":\"set-1\",\"status\":\"succeeded\"}\n",
+		},
+		{
+			name: "deployment fails",
+			flags: flags(map[string]string{constants.SetFlagName: "set-1", constants.WaitFlagName: "true",
+				constants.IntervalFlagName: "1ms", constants.TimeoutFlagName: "1s"}),
+			mockClient: &test.MockClient{
+				Deployments:        []humanitec.Deployment{{ID: "deploy-development", EnvID: "development", SetID: "set-1"}},
+				DeploymentStatuses: []string{"in progress", "failed"},
+			},
+			expectedExit: clierrors.ExitError,
+		},
+		{
+			name: "timeout",
+			flags: flags(map[string]string{constants.SetFlagName: "set-1", constants.WaitFlagName: "true",
+				constants.IntervalFlagName: "5ms", constants.TimeoutFlagName: "20ms"}),
+			mockClient: &test.MockClient{
+				Deployments:        []humanitec.Deployment{{ID: "deploy-development", EnvID: "development", SetID: "set-1"}},
+				DeploymentStatuses: []string{"in progress"},
+			},
+			expectedExit: clierrors.ExitTimeout,
+		},
+		{
+			name:         "neither delta nor set",
+			flags:        flags(nil),
+			mockClient:   &test.MockClient{},
+			expectedExit: clierrors.ExitValidation,
+		},
+		{
+			name:         "both delta and set",
+			flags:        flags(map[string]string{constants.DeltaFlagName: "delta-1", constants.SetFlagName: "set-1"}),
+			mockClient:   &test.MockClient{},
+			expectedExit: clierrors.ExitValidation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			test.SetupMockClient(t, tt.mockClient)
+
+			got, err := test.ExecuteCommand(t, deploy, deploy, nil, tt.flags)
+			assert.Equal(t, tt.expectedExit, clierrors.ExitCode(err), "unexpected error: %v", err)
+			if tt.expectedExit == clierrors.ExitOK {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
+
+// TestPrintErrorSummary verifies that the errors of a failed deployment are summarized with the
+// time each was reported.
+func TestPrintErrorSummary(t *testing.T) {
+	mockClient := &test.MockClient{
+		Deployments: []humanitec.Deployment{
+			{ID: "deploy-2", EnvID: "development", SetID: "set-2", DeltaID: "delta-1", FromID: "deploy-1", Comment: "Add worker", Status: "failed",
+				StatusChangedAt: "2024-01-03T00:00:00Z", CreatedAt: "2024-01-02T00:00:00Z", CreatedBy: "user-1"},
+			{ID: "deploy-1", EnvID: "development", SetID: "set-1", Comment: "Initial", Status: "succeeded", CreatedAt: "2024-01-01T00:00:00Z"},
+		},
+		DeploymentErrors: []humanitec.DeploymentError{
+			{Scope: "workload", ObjectID: "worker", Code: "CrashLoopBackOff", Summary: "Container worker keeps crashing",
+				Message: "Back-off restarting failed container worker", CreatedAt: "2024-01-03T00:00:00Z"},
+		},
+		DeploymentLogs: []humanitec.DeploymentLog{
+			{Step: "provision", Timestamp: "2024-01-02T00:00:01Z", Level: "info", Message: "Provisioned 2 resources"},
+			{Step: "deploy", Timestamp: "2024-01-02T00:00:05Z", Level: "error", Message: "Container worker keeps crashing"},
+		},
+	}
+
+	var out bytes.Buffer
+	err := printErrorSummary(&out, mockClient, "test-app", "development", "deploy-2")
+	assert.NoError(t, err)
+	assert.Equal(t, "Deployment deploy-2 failed:\n"+
+		"  TIME                  SCOPE     OBJECT  CODE              MESSAGE\n"+
+		"  2024-01-03T00:00:00Z  workload  worker  CrashLoopBackOff  Container worker keeps crashing\n", out.String())
+}
+
+// TestDeployCommandConfiguration verifies that the deploy command is properly configured
+// with the correct name, description, and flags.
+func TestDeployCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.DeployCmdUse, deploy.Name(), "deploy command should have correct use")
+	assert.Equal(t, constants.DeployCmdShort, deploy.Short, "deploy command should have correct short description")
+
+	for _, flag := range []string{constants.AppFlagName, constants.EnvFlagName, constants.DeltaFlagName, constants.SetFlagName,
+		constants.CommentFlagName, constants.WaitFlagName, constants.TimeoutFlagName} {
+		assert.True(t, deploy.Flags().Lookup(flag) != nil, "deploy command should have %s flag", flag)
+	}
+}
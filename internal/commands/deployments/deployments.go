@@ -0,0 +1,40 @@
+package deployments
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// GetCommand returns the command for getting deployments
+func GetCommand() *cobra.Command {
+	return get
+}
+
+// DescribeCommand returns the command for describing a deployment
+func DescribeCommand() *cobra.Command {
+	return describe
+}
+
+// LogsCommand returns the command for showing the logs of a deployment
+func LogsCommand() *cobra.Command {
+	return logs
+}
+
+// DeployCommand returns the command for starting a deployment
+func DeployCommand() *cobra.Command {
+	return deploy
+}
+
+// WaitCommand returns the command for waiting on a deployment
+func WaitCommand() *cobra.Command {
+	return wait
+}
+
+// RollbackCommand returns the command for rolling back an environment
+func RollbackCommand() *cobra.Command {
+	return rollback
+}
+
+// PromoteCommand returns the command for promoting deployments between environments
+func PromoteCommand() *cobra.Command {
+	return promote
+}
@@ -0,0 +1,103 @@
+package deployments
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for describing a deployment
+	describe = &cobra.Command{
+		Use:   constants.DeploymentCmdUse + " <id>",
+		Short: constants.DeploymentCmdShort,
+		Long: `Show a detailed summary of a deployment.
+The deployment's status, deployment set, delta and creation details are shown together
+with the errors reported for failed deployments. With --errors only the errors are listed,
+with the time each was reported.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, env, err := appEnv(cmd)
+			if err != nil {
+				return err
+			}
+
+			errorsOnly, err := cmd.Flags().GetBool(constants.ErrorsFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get errors flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			deployment, err := client.GetDeployment(app, env, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get deployment: %w", err)
+			}
+
+			if errorsOnly {
+				deploymentErrors, err := client.GetDeploymentErrors(app, env, deployment.ID)
+				if err != nil {
+					return fmt.Errorf("failed to get deployment errors: %w", err)
+				}
+
+				// Print output
+				formatted, err := output.FormatDeploymentErrors(deploymentErrors, outputFormat)
+				if err != nil {
+					return fmt.Errorf("failed to format output: %w", err)
+				}
+				fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+				return nil
+			}
+
+			// Only failed deployments report errors
+			description := &output.DeploymentDescription{Deployment: deployment}
+			if deployment.Status == humanitec.DeploymentFailed {
+				if description.Errors, err = client.GetDeploymentErrors(app, env, deployment.ID); err != nil {
+					return fmt.Errorf("failed to get deployment errors: %w", err)
+				}
+			}
+
+			// Print output
+			formatted, err := output.FormatDeploymentDescription(description, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(describe)
+
+	// Add command-specific flags
+	describe.Flags().Bool(constants.ErrorsFlagName, false, constants.ErrorsFlagHelp)
+}
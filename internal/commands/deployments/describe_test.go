@@ -0,0 +1,103 @@
+package deployments
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDescribeDeploymentCommandExecution verifies that describe deployment shows the details
+// of a deployment and the errors of failed deployments.
+func TestDescribeDeploymentCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		expectedOutput string
+		expectError    bool
+	}{
+		{
+			name:  "failed deployment with errors",
+			args:  []string{"deploy-2"},
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development"},
+			expectedOutput: "ID:                 deploy-2\n" +
+				"Environment:        development\n" +
+				"Status:             failed\n" +
+				"Status Changed At:  2024-01-03T00:00:00Z\n" +
+				"Set:                set-2\n" +
+				"Delta:              delta-1\n" +
+				"From:               deploy-1\n" +
+				"Created At:         2024-01-02T00:00:00Z\n" +
+				"Created By:         user-1\n" +
+				"Comment:            Add worker\n" +
+				"\nErrors:\n" +
+				"  TIME                  SCOPE     OBJECT  CODE              MESSAGE\n" +
+				"  2024-01-03T00:00:00Z  workload  worker  CrashLoopBackOff  Container worker keeps crashing\n",
+		},
+		{
+			name:           "succeeded deployment - json format",
+			args:           []string{"deploy-1"},
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development", constants.OutputFlagName: "json"},
+			expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"DeploymentDescription\",\n  \"item\": {\n    \"deployment\": {\n      \"id\": \"deploy-1\",\n      \"env_id\": \"development\",\n      \"set_id\": \"set-1\",\n      \"comment\": \"Initial\",\n      \"status\": \"succeeded\",\n      \"created_at\": \"2024-01-01T00:00:00Z\"\n    },\n    \"errors\": []\n  }\n}\n",
+		},
+		{
+			name:  "errors only",
+			args:  []string{"deploy-2"},
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development", constants.ErrorsFlagName: "true"},
+			expectedOutput: "TIME\tSCOPE\tOBJECT\tCODE\tMESSAGE\n----\t-----\t------\t----\t-------\n" +
+				"2024-01-03T00:00:00Z\tworkload\tworker\tCrashLoopBackOff\tContainer worker keeps crashing\n",
+		},
+		{
+			name:  "errors only - wide format",
+			args:  []string{"deploy-2"},
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development", constants.ErrorsFlagName: "true", constants.OutputFlagName: "wide"},
+			expectedOutput: "TIME\tSCOPE\tOBJECT\tCODE\tSUMMARY\tMESSAGE\n----\t-----\t------\t----\t-------\t-------\n" +
+				"2024-01-03T00:00:00Z\tworkload\tworker\tCrashLoopBackOff\tContainer worker keeps crashing\tBack-off restarting failed container worker\n",
+		},
+		{
+			name:           "errors only - ndjson format",
+			args:           []string{"deploy-2"},
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development", constants.ErrorsFlagName: "true", constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"scope\":\"workload\",\"object_id\":\"worker\",\"code\":\"CrashLoopBackOff\",\"summary\":\"Container worker keeps crashing\",\"message\":\"Back-off restarting failed container worker\",\"created_at\":\"2024-01-03T00:00:00Z\"}\n",
+		},
+		{
+			name:        "deployment not found",
+			args:        []string{"deploy-3"},
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development"},
+			expectError: true,
+		},
+	}
+
+	mockClient := &test.MockClient{
+		Deployments: []humanitec.Deployment{
+			{ID: "deploy-2", EnvID: "development", SetID: "set-2", DeltaID: "delta-1", FromID: "deploy-1", Comment: "Add worker", Status: "failed",
+				StatusChangedAt: "2024-01-03T00:00:00Z", CreatedAt: "2024-01-02T00:00:00Z", CreatedBy: "user-1"},
+			{ID: "deploy-1", EnvID: "development", SetID: "set-1", Comment: "Initial", Status: "succeeded", CreatedAt: "2024-01-01T00:00:00Z"},
+		},
+		DeploymentErrors: []humanitec.DeploymentError{
+			{Scope: "workload", ObjectID: "worker", Code: "CrashLoopBackOff", Summary: "Container worker keeps crashing",
+				Message: "Back-off restarting failed container worker", CreatedAt: "2024-01-03T00:00:00Z"},
+		},
+		DeploymentLogs: []humanitec.DeploymentLog{
+			{Step: "provision", Timestamp: "2024-01-02T00:00:01Z", Level: "info", Message: "Provisioned 2 resources"},
+			{Step: "deploy", Timestamp: "2024-01-02T00:00:05Z", Level: "error", Message: "Container worker keeps crashing"},
+		},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(t, describe, describe, tt.args, tt.flags)
+			if (err != nil) != tt.expectError {
+				t.Errorf("describe.Execute() error = %v, wantErr %v", err, tt.expectError)
+				return
+			}
+			if !tt.expectError {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
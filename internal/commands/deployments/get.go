@@ -0,0 +1,121 @@
+package deployments
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for getting deployments
+	get = &cobra.Command{
+		Use:     constants.DeploymentsCmdUse + " [id]",
+		Aliases: []string{constants.DeploymentCmdUse},
+		Short:   constants.DeploymentsCmdShort,
+		Long: `List the deployments of an environment, most recent first, or get a single deployment by ID.
+Wide output adds the delta, the previous deployment and who started each deployment.
+With --watch the list is refreshed every --interval and new deployments and status changes
+are printed as ADDED and MODIFIED events until interrupted. When writing a table to a
+terminal, the whole table is redrawn instead.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, env, err := appEnv(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			watchChanges, interval, err := apps.WatchSettings(cmd)
+			if err != nil {
+				return err
+			}
+			if watchChanges && len(args) == 1 {
+				return clierrors.Validationf("--%s is only supported when listing all deployments", constants.WatchFlagName)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			if watchChanges {
+				return watchDeployments(cmd, client, app, env, outputFormat, interval)
+			}
+
+			// If ID is provided, get single deployment
+			if len(args) == 1 {
+				deployment, err := client.GetDeployment(app, env, args[0])
+				if err != nil {
+					return fmt.Errorf("failed to get deployment: %w", err)
+				}
+
+				// Print output
+				formatted, err := output.FormatDeployment(deployment, outputFormat)
+				if err != nil {
+					return fmt.Errorf("failed to format output: %w", err)
+				}
+				fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+				return nil
+			}
+
+			// Otherwise, list the deployments of the environment
+			deployments, err := client.GetDeployments(app, env)
+			if err != nil {
+				return fmt.Errorf("failed to list deployments: %w", err)
+			}
+
+			// Print output
+			formatted, err := output.FormatDeployments(deployments, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+// watchDeployments prints the deployments of an environment and then the changes to them until
+// the command is interrupted.
+func watchDeployments(cmd *cobra.Command, client humanitec.Client, app, env string, outputFormat output.Format, interval time.Duration) error {
+	list := func() ([]humanitec.Deployment, error) {
+		return client.GetDeployments(app, env)
+	}
+
+	err := output.Watch(cmd.Context(), cmd.OutOrStdout(), interval, list,
+		func(deployment humanitec.Deployment) string { return deployment.ID }, output.FormatDeployments, outputFormat)
+	if err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+	return nil
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(get)
+	apps.WatchFlagSet()(get)
+}
@@ -0,0 +1,149 @@
+package deployments
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGetDeploymentCommandExecution verifies that the get deployments command lists the
+// deployments of an environment or gets a single deployment, in every output format.
+func TestGetDeploymentCommandExecution(t *testing.T) {
+	appEnv := map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development"}
+	withOutput := func(format string) map[string]string {
+		return map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development", constants.OutputFlagName: format}
+	}
+
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		expectedOutput string
+		expectError    bool
+	}{
+		{
+			name:  "list deployments - table format",
+			flags: appEnv,
+			expectedOutput: "ID\tSTATUS\tSET\tCREATED AT\tCOMMENT\n--\t------\t---\t----------\t-------\n" +
+				"deploy-2\tfailed\tset-2\t2024-01-02T00:00:00Z\tAdd worker\n" +
+				"deploy-1\tsucceeded\tset-1\t2024-01-01T00:00:00Z\tInitial\n",
+		},
+		{
+			name:  "list deployments - wide format",
+			flags: withOutput("wide"),
+			expectedOutput: "ID\tSTATUS\tSET\tDELTA\tFROM\tCREATED AT\tCREATED BY\tSTATUS CHANGED AT\tCOMMENT\n" +
+				"--\t------\t---\t-----\t----\t----------\t----------\t-----------------\t-------\n" +
+				"deploy-2\tfailed\tset-2\tdelta-1\tdeploy-1\t2024-01-02T00:00:00Z\tuser-1\t2024-01-03T00:00:00Z\tAdd worker\n" +
+				"deploy-1\tsucceeded\tset-1\t<none>\t<none>\t2024-01-01T00:00:00Z\t<none>\t<none>\tInitial\n",
+		},
+		{
+			name:  "list deployments - ndjson format",
+			flags: withOutput("ndjson"),
+			expectedOutput: "{\"id\":\"deploy-2\",\"env_id\":\"development\",\"set_id\":\"set-2\",\"delta_id\":\"delta-1\",\"from_id\":\"deploy-1\",\"comment\":\"Add worker\",\"status\":\"failed\",\"status_changed_at\":\"2024-01-03T00:00:00Z\",\"created_at\":\"2024-01-02T00:00:00Z\",\"created_by\":\"user-1\"}\n" +
+				"{\"id\":\"deploy-1\",\"env_id\":\"development\",\"set_id\":\"set-1\",\"comment\":\"Initial\",\"status\":\"succeeded\",\"created_at\":\"2024-01-01T00:00:00Z\"}\n",
+		},
+		{
+			name:           "get single deployment - yaml format",
+			args:           []string{"deploy-1"},
+			flags:          withOutput("yaml"),
+			expectedOutput: "apiVersion: humctl-wrapper/v1\nkind: Deployment\nitem:\n    id: deploy-1\n    env_id: development\n    set_id: set-1\n    comment: Initial\n    status: succeeded\n    created_at: \"2024-01-01T00:00:00Z\"\n",
+		},
+		{
+			name:        "deployment not found",
+			args:        []string{"deploy-3"},
+			flags:       appEnv,
+			expectError: true,
+		},
+		{
+			name:        "missing env flag",
+			flags:       map[string]string{constants.AppFlagName: "test-app"},
+			expectError: true,
+		},
+		{
+			name:        "invalid env id",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "Dev_1"},
+			expectError: true,
+		},
+	}
+
+	mockClient := &test.MockClient{
+		Deployments: []humanitec.Deployment{
+			{ID: "deploy-2", EnvID: "development", SetID: "set-2", DeltaID: "delta-1", FromID: "deploy-1", Comment: "Add worker", Status: "failed",
+				StatusChangedAt: "2024-01-03T00:00:00Z", CreatedAt: "2024-01-02T00:00:00Z", CreatedBy: "user-1"},
+			{ID: "deploy-1", EnvID: "development", SetID: "set-1", Comment: "Initial", Status: "succeeded", CreatedAt: "2024-01-01T00:00:00Z"},
+		},
+		DeploymentErrors: []humanitec.DeploymentError{
+			{Scope: "workload", ObjectID: "worker", Code: "CrashLoopBackOff", Summary: "Container worker keeps crashing",
+				Message: "Back-off restarting failed container worker", CreatedAt: "2024-01-03T00:00:00Z"},
+		},
+		DeploymentLogs: []humanitec.DeploymentLog{
+			{Step: "provision", Timestamp: "2024-01-02T00:00:01Z", Level: "info", Message: "Provisioned 2 resources"},
+			{Step: "deploy", Timestamp: "2024-01-02T00:00:05Z", Level: "error", Message: "Container worker keeps crashing"},
+		},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(t, get, get, tt.args, tt.flags)
+			if (err != nil) != tt.expectError {
+				t.Errorf("get.Execute() error = %v, wantErr %v", err, tt.expectError)
+				return
+			}
+			if !tt.expectError {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
+
+// TestGetDeploymentsWatch verifies that --watch prints the initial deployments as ADDED events
+// followed by new deployments and status changes.
+func TestGetDeploymentsWatch(t *testing.T) {
+	deploy1 := humanitec.Deployment{ID: "deploy-1", EnvID: "development", SetID: "set-1", Status: "succeeded"}
+	test.SetupMockClient(t, &test.MockClient{DeploymentLists: [][]humanitec.Deployment{
+		{deploy1},
+		{{ID: "deploy-2", EnvID: "development", SetID: "set-2", Status: "in progress"}, deploy1},
+		{{ID: "deploy-2", EnvID: "development", SetID: "set-2", Status: "succeeded"}, deploy1},
+	}})
+
+	// Watching runs until interrupted, so stop it once all lists have been seen
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	got, err := test.ExecuteCommandContext(t, ctx, get, get, nil, map[string]string{
+		constants.AppFlagName:      "test-app",
+		constants.EnvFlagName:      "development",
+		constants.WatchFlagName:    "true",
+		constants.IntervalFlagName: "1ms",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "EVENT\tID\tSTATUS\tSET\tCREATED AT\tCOMMENT\n-----\t--\t------\t---\t----------\t-------\n"+
+		"ADDED\tdeploy-1\tsucceeded\tset-1\t<none>\t\n"+
+		"ADDED\tdeploy-2\tin progress\tset-2\t<none>\t\n"+
+		"MODIFIED\tdeploy-2\tsucceeded\tset-2\t<none>\t\n", got)
+
+	// Watching a single deployment is not supported
+	_, err = test.ExecuteCommand(t, get, get, []string{"deploy-1"}, map[string]string{
+		constants.AppFlagName: "test-app", constants.EnvFlagName: "development", constants.WatchFlagName: "true",
+	})
+	assert.Error(t, err)
+}
+
+// TestGetDeploymentCommandConfiguration verifies that the get deployments command is properly configured
+// with the correct name, description, and flags.
+func TestGetDeploymentCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.DeploymentsCmdUse, get.Name(), "get command should have correct use")
+	assert.Equal(t, constants.DeploymentsCmdShort, get.Short, "get command should have correct short description")
+
+	assert.True(t, get.Flags().Lookup(constants.AppFlagName) != nil, "get command should have app flag")
+	assert.True(t, get.Flags().Lookup(constants.EnvFlagName) != nil, "get command should have env flag")
+	assert.True(t, get.Flags().Lookup(constants.OutputFlagName) != nil, "get command should have output flag")
+	assert.True(t, get.Flags().Lookup(constants.WatchFlagName) != nil, "get command should have watch flag")
+}
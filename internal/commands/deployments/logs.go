@@ -0,0 +1,69 @@
+package deployments
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for showing the logs of a deployment
+	logs = &cobra.Command{
+		Use:   constants.DeploymentCmdUse + " <id>",
+		Short: constants.DeploymentCmdShort,
+		Long: `Show the log lines written by the steps of a deployment, oldest first.
+Each line is printed with its timestamp, step and level; error lines are highlighted.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, env, err := appEnv(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			deploymentLogs, err := client.GetDeploymentLogs(app, env, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get deployment logs: %w", err)
+			}
+
+			// Print output
+			formatted, err := output.FormatDeploymentLogs(deploymentLogs, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(logs)
+}
@@ -0,0 +1,85 @@
+package deployments
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLogsDeploymentCommandExecution verifies that logs deployment prints the log lines of the
+// steps of a deployment with their timestamps.
+func TestLogsDeploymentCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		expectedOutput string
+		expectedExit   int
+	}{
+		{
+			name:  "table format",
+			args:  []string{"deploy-2"},
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development"},
+			expectedOutput: "TIMESTAMP\tSTEP\tLEVEL\tMESSAGE\n---------\t----\t-----\t-------\n" +
+				"2024-01-02T00:00:01Z\tprovision\tinfo\tProvisioned 2 resources\n" +
+				"2024-01-02T00:00:05Z\tdeploy\terror\tContainer worker keeps crashing\n",
+		},
+		{
+			name:  "ndjson format",
+			args:  []string{"deploy-2"},
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development", constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"step\":\"provision\",\"timestamp\":\"2024-01-02T00:00:01Z\",\"level\":\"info\",\"message\":\"Provisioned 2 resources\"}\n" +
+				"{\"step\":\"deploy\",\"timestamp\":\"2024-01-02T00:00:05Z\",\"level\":\"error\",\"message\":\"Container worker keeps crashing\"}\n",
+		},
+		{
+			name:         "deployment not found",
+			args:         []string{"deploy-3"},
+			flags:        map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development"},
+			expectedExit: clierrors.ExitNotFound,
+		},
+		{
+			name:         "missing id",
+			flags:        map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development"},
+			expectedExit: clierrors.ExitValidation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &test.MockClient{
+				Deployments: []humanitec.Deployment{
+					{ID: "deploy-2", EnvID: "development", SetID: "set-2", DeltaID: "delta-1", FromID: "deploy-1", Comment: "Add worker", Status: "failed",
+						StatusChangedAt: "2024-01-03T00:00:00Z", CreatedAt: "2024-01-02T00:00:00Z", CreatedBy: "user-1"},
+					{ID: "deploy-1", EnvID: "development", SetID: "set-1", Comment: "Initial", Status: "succeeded", CreatedAt: "2024-01-01T00:00:00Z"},
+				},
+				DeploymentErrors: []humanitec.DeploymentError{
+					{Scope: "workload", ObjectID: "worker", Code: "CrashLoopBackOff", Summary: "Container worker keeps crashing",
+						Message: "Back-off restarting failed container worker", CreatedAt: "2024-01-03T00:00:00Z"},
+				},
+				DeploymentLogs: []humanitec.DeploymentLog{
+					{Step: "provision", Timestamp: "2024-01-02T00:00:01Z", Level: "info", Message: "Provisioned 2 resources"},
+					{Step: "deploy", Timestamp: "2024-01-02T00:00:05Z", Level: "error", Message: "Container worker keeps crashing"},
+				},
+			}
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommand(t, logs, logs, tt.args, tt.flags)
+			assert.Equal(t, tt.expectedExit, clierrors.ExitCode(err), "unexpected error: %v", err)
+			if tt.expectedExit == clierrors.ExitOK {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
+
+// TestLogsDeploymentCommandConfiguration verifies that the logs deployment command is properly
+// configured with the correct name and flags.
+func TestLogsDeploymentCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.DeploymentCmdUse, logs.Name(), "logs command should have correct use")
+	assert.True(t, logs.Flags().Lookup(constants.AppFlagName) != nil, "logs command should have app flag")
+	assert.True(t, logs.Flags().Lookup(constants.EnvFlagName) != nil, "logs command should have env flag")
+}
@@ -0,0 +1,191 @@
+package deployments
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command for promoting deployments between environments
+	promote = &cobra.Command{
+		Use:   constants.PromoteCmdUse,
+		Short: constants.PromoteCmdShort,
+		Long: `Promote the deployment set currently active in one environment to another, e.g. from
+development to staging. With --require-success the command fails unless the current deployment
+of the source environment succeeded. The differences between the sets of the two environments
+are shown and the target environment ID must be typed to confirm. Use --yes to skip the
+confirmation in automation, and --dry-run to print the request without sending it. With --wait
+the command waits until the deployment has finished and fails, after summarizing its errors,
+if the deployment failed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Get flags
+			app, err := cmd.Flags().GetString(constants.AppFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get app flag: %w", err)
+			}
+
+			from, err := cmd.Flags().GetString(constants.FromFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get from flag: %w", err)
+			}
+
+			to, err := cmd.Flags().GetString(constants.ToFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get to flag: %w", err)
+			}
+
+			requireSuccess, err := cmd.Flags().GetBool(constants.RequireSuccessFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get require-success flag: %w", err)
+			}
+
+			comment, err := cmd.Flags().GetString(constants.CommentFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get comment flag: %w", err)
+			}
+
+			yes, err := cmd.Flags().GetBool(constants.YesFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get yes flag: %w", err)
+			}
+
+			dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Validate input before calling the API
+			if err := validation.ID(validation.App, app); err != nil {
+				return err
+			}
+			if err := validation.ID(validation.Environment, from); err != nil {
+				return err
+			}
+			if err := validation.ID(validation.Environment, to); err != nil {
+				return err
+			}
+			if from == to {
+				return clierrors.Validationf("--%s and --%s must be different environments", constants.FromFlagName, constants.ToFlagName)
+			}
+
+			// Make sure the promotion can be confirmed before looking anything up
+			confirmer := prompt.NewConfirmer(cmd.InOrStdin(), cmd.ErrOrStderr(), yes)
+			if !dryRun {
+				if err := confirmer.Check("promote deployments"); err != nil {
+					return err
+				}
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			source, err := client.GetEnv(app, from)
+			if err != nil {
+				return fmt.Errorf("failed to get env %s: %w", from, err)
+			}
+			if source.LastDeploy == nil {
+				return fmt.Errorf("env %s has never been deployed", from)
+			}
+			if requireSuccess && source.LastDeploy.Status != humanitec.DeploymentSucceeded {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("deployment %s of env %s is %s, not %s", source.LastDeploy.ID, from, source.LastDeploy.Status, humanitec.DeploymentSucceeded)
+			}
+
+			target, err := client.GetEnv(app, to)
+			if err != nil {
+				return fmt.Errorf("failed to get env %s: %w", to, err)
+			}
+			currentSetID := ""
+			if target.LastDeploy != nil {
+				currentSetID = target.LastDeploy.SetID
+			}
+
+			setID := source.LastDeploy.SetID
+			if setID == currentSetID {
+				formatted, err := output.FormatMessage(fmt.Sprintf("Env %s already runs deployment set %s of env %s", to, setID, from), outputFormat)
+				if err != nil {
+					return fmt.Errorf("failed to format output: %w", err)
+				}
+				fmt.Fprint(cmd.OutOrStdout(), formatted)
+				return nil
+			}
+
+			if comment == "" {
+				comment = fmt.Sprintf("Promote deployment %s from env %s", source.LastDeploy.ID, from)
+			}
+			req := humanitec.DeploymentRequest{SetID: setID, Comment: comment}
+
+			// Print the request instead of sending it on a dry run
+			if dryRun {
+				return apps.PrintRequests(cmd, []humanitec.Request{humanitec.CreateDeploymentRequest(org, app, to, req)}, outputFormat)
+			}
+
+			// Show what will change and ask for confirmation
+			diff, err := setDiff(client, app, currentSetID, setID)
+			if err != nil {
+				return err
+			}
+			out := cmd.ErrOrStderr()
+			fmt.Fprintf(out, "Deployment %s (%s) of env %s will be promoted to env %s of application %s:\n",
+				source.LastDeploy.ID, source.LastDeploy.Status, from, to, app)
+			fmt.Fprint(out, diff)
+
+			confirmed, err := confirmer.ConfirmByTyping(to)
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				return prompt.ErrAborted
+			}
+
+			return Deploy(cmd, client, app, to, req, outputFormat)
+		},
+	}
+)
+
+func init() {
+	// Add common flags
+	apps.CommonFlagSet()(promote)
+	DeployFlagSet()(promote)
+
+	// Add command-specific flags
+	promote.Flags().StringP(constants.AppFlagName, constants.AppFlagShort, "", constants.AppIDFlagHelp)
+	promote.Flags().String(constants.FromFlagName, "", constants.PromoteFromFlagHelp)
+	promote.Flags().String(constants.ToFlagName, "", constants.PromoteToFlagHelp)
+	promote.Flags().Bool(constants.RequireSuccessFlagName, false, constants.RequireSuccessFlagHelp)
+	promote.Flags().BoolP(constants.YesFlagName, constants.YesFlagShort, false, constants.YesFlagHelp)
+	promote.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
+
+	// Mark required flags
+	promote.MarkFlagRequired(constants.AppFlagName)
+	promote.MarkFlagRequired(constants.FromFlagName)
+	promote.MarkFlagRequired(constants.ToFlagName)
+}
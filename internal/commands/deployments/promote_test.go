@@ -0,0 +1,195 @@
+package deployments
+
+import (
+	"io"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPromoteCommandExecution verifies that promote deploys the set of the source environment
+// to the target environment.
+func TestPromoteCommandExecution(t *testing.T) {
+	flags := func(from, to string, extra map[string]string) map[string]string {
+		f := map[string]string{constants.AppFlagName: "test-app", constants.FromFlagName: from, constants.ToFlagName: to}
+		for k, v := range extra {
+			f[k] = v
+		}
+		return f
+	}
+
+	testCases := []struct {
+		name            string
+		flags           map[string]string
+		expectedOutput  string
+		expectedRequest *humanitec.DeploymentRequest
+		expectedErr     int
+	}{
+		{
+			name:            "promote to staging",
+			flags:           flags("development", "staging", map[string]string{constants.RequireSuccessFlagName: "true", constants.YesFlagName: "true", constants.OutputFlagName: "ndjson"}),
+			expectedOutput:  "{\"id\":\"deploy-staging\",\"env_id\":\"staging\",\"set_id\":\"set-2\",\"comment\":\"Promote deployment deploy-1 from env development\",\"status\":\"pending\"}\n",
+			expectedRequest: &humanitec.DeploymentRequest{SetID: "set-2", Comment: "Promote deployment deploy-1 from env development"},
+		},
+		{
+			name:            "promote failed deployment to never deployed env",
+			flags:           flags("staging", "production", map[string]string{constants.CommentFlagName: "Hotfix", constants.YesFlagName: "true", constants.OutputFlagName: "ndjson"}),
+			expectedOutput:  "{\"id\":\"deploy-production\",\"env_id\":\"production\",\"set_id\":\"set-1\",\"comment\":\"Hotfix\",\"status\":\"pending\"}\n",
+			expectedRequest: &humanitec.DeploymentRequest{SetID: "set-1", Comment: "Hotfix"},
+		},
+		{
+			name:           "dry run",
+			flags:          flags("development", "staging", map[string]string{constants.DryRunFlagName: "true", constants.OrgFlagName: "test-org"}),
+			expectedOutput: "DRY RUN: POST /orgs/test-org/apps/test-app/envs/staging/deploys\n{\n  \"set_id\": \"set-2\",\n  \"comment\": \"Promote deployment deploy-1 from env development\"\n}\n",
+		},
+		{
+			name:        "source deployment failed",
+			flags:       flags("staging", "production", map[string]string{constants.RequireSuccessFlagName: "true", constants.YesFlagName: "true"}),
+			expectedErr: clierrors.ExitError,
+		},
+		{
+			name:        "source never deployed",
+			flags:       flags("production", "staging", map[string]string{constants.YesFlagName: "true"}),
+			expectedErr: clierrors.ExitError,
+		},
+		{
+			name:        "source not found",
+			flags:       flags("qa", "staging", map[string]string{constants.YesFlagName: "true"}),
+			expectedErr: clierrors.ExitNotFound,
+		},
+		{
+			name:        "same environment",
+			flags:       flags("staging", "staging", map[string]string{constants.YesFlagName: "true"}),
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "requires confirmation",
+			flags:       flags("development", "staging", nil),
+			expectedErr: clierrors.ExitValidation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &test.MockClient{
+				Envs: []humanitec.Environment{
+					{ID: "development", LastDeploy: &humanitec.Deployment{ID: "deploy-1", SetID: "set-2", Status: "succeeded"}},
+					{ID: "staging", LastDeploy: &humanitec.Deployment{ID: "deploy-2", SetID: "set-1", Status: "failed"}},
+					{ID: "production"},
+				},
+				Sets: map[string]*humanitec.DeploymentSet{
+					"set-1": {ID: "set-1", Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.0"}}},
+					"set-2": {ID: "set-2", Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.1"}}},
+				},
+			}
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommand(t, promote, promote, nil, tt.flags)
+			if tt.expectedErr != 0 {
+				assert.Equal(t, tt.expectedErr, clierrors.ExitCode(err), "unexpected error: %v", err)
+				assert.Empty(t, mockClient.CreatedDeployments)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, got)
+			if tt.expectedRequest != nil {
+				assert.Equal(t, []humanitec.DeploymentRequest{*tt.expectedRequest}, mockClient.CreatedDeployments)
+			}
+		})
+	}
+}
+
+// TestPromoteAlreadyDeployed verifies that promoting a set the target already runs does nothing.
+func TestPromoteAlreadyDeployed(t *testing.T) {
+	mockClient := &test.MockClient{
+		Envs: []humanitec.Environment{
+			{ID: "development", LastDeploy: &humanitec.Deployment{ID: "deploy-1", SetID: "set-2", Status: "succeeded"}},
+			{ID: "staging", LastDeploy: &humanitec.Deployment{ID: "deploy-2", SetID: "set-1", Status: "failed"}},
+			{ID: "production"},
+		},
+		Sets: map[string]*humanitec.DeploymentSet{
+			"set-1": {ID: "set-1", Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.0"}}},
+			"set-2": {ID: "set-2", Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.1"}}},
+		},
+	}
+	mockClient.Envs[1].LastDeploy.SetID = "set-2"
+	test.SetupMockClient(t, mockClient)
+
+	got, err := test.ExecuteCommand(t, promote, promote, nil, map[string]string{
+		constants.AppFlagName: "test-app", constants.FromFlagName: "development", constants.ToFlagName: "staging", constants.YesFlagName: "true",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Env staging already runs deployment set set-2 of env development\n", got)
+	assert.Empty(t, mockClient.CreatedDeployments)
+}
+
+// TestPromoteWait verifies that promote --wait waits until the promoted deployment has finished.
+func TestPromoteWait(t *testing.T) {
+	mockClient := &test.MockClient{
+		Envs: []humanitec.Environment{
+			{ID: "development", LastDeploy: &humanitec.Deployment{ID: "deploy-1", SetID: "set-2", Status: "succeeded"}},
+			{ID: "staging", LastDeploy: &humanitec.Deployment{ID: "deploy-2", SetID: "set-1", Status: "failed"}},
+			{ID: "production"},
+		},
+		Sets: map[string]*humanitec.DeploymentSet{
+			"set-1": {ID: "set-1", Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.0"}}},
+			"set-2": {ID: "set-2", Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.1"}}},
+		},
+	}
+	mockClient.Deployments = []humanitec.Deployment{{ID: "deploy-staging", EnvID: "staging", SetID: "set-2"}}
+	mockClient.DeploymentStatuses = []string{humanitec.DeploymentInProgress, humanitec.DeploymentSucceeded}
+	test.SetupMockClient(t, mockClient)
+
+	got, err := test.ExecuteCommand(t, promote, promote, nil, map[string]string{
+		constants.AppFlagName: "test-app", constants.FromFlagName: "development", constants.ToFlagName: "staging", constants.YesFlagName: "true",
+		constants.WaitFlagName: "true", constants.IntervalFlagName: "1ms", constants.OutputFlagName: "ndjson",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"id\":\"deploy-staging\",\"env_id\":\"staging\",\"set_id\":\"set-2\",\"status\":\"succeeded\"}\n", got)
+}
+
+// TestPromoteConfirmation verifies that the target environment ID must be typed to confirm a promotion.
+func TestPromoteConfirmation(t *testing.T) {
+	isInteractive := prompt.IsInteractive
+	prompt.IsInteractive = func(io.Reader) bool { return true }
+	defer func() { prompt.IsInteractive = isInteractive }()
+
+	mockClient := &test.MockClient{
+		Envs: []humanitec.Environment{
+			{ID: "development", LastDeploy: &humanitec.Deployment{ID: "deploy-1", SetID: "set-2", Status: "succeeded"}},
+			{ID: "staging", LastDeploy: &humanitec.Deployment{ID: "deploy-2", SetID: "set-1", Status: "failed"}},
+			{ID: "production"},
+		},
+		Sets: map[string]*humanitec.DeploymentSet{
+			"set-1": {ID: "set-1", Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.0"}}},
+			"set-2": {ID: "set-2", Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.1"}}},
+		},
+	}
+	test.SetupMockClient(t, mockClient)
+	flags := map[string]string{constants.AppFlagName: "test-app", constants.FromFlagName: "development", constants.ToFlagName: "staging"}
+
+	_, err := test.ExecuteCommandWithInput(t, promote, promote, nil, flags, "development\n")
+	assert.ErrorIs(t, err, prompt.ErrAborted)
+	assert.Empty(t, mockClient.CreatedDeployments)
+
+	_, err = test.ExecuteCommandWithInput(t, promote, promote, nil, flags, "staging\n")
+	assert.NoError(t, err)
+	assert.Len(t, mockClient.CreatedDeployments, 1)
+}
+
+// TestPromoteCommandConfiguration verifies that the promote command is properly configured
+// with the correct name, description, and flags.
+func TestPromoteCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.PromoteCmdUse, promote.Name(), "promote command should have correct use")
+	assert.Equal(t, constants.PromoteCmdShort, promote.Short, "promote command should have correct short description")
+
+	for _, flag := range []string{constants.AppFlagName, constants.FromFlagName, constants.ToFlagName, constants.RequireSuccessFlagName,
+		constants.YesFlagName, constants.DryRunFlagName, constants.CommentFlagName, constants.WaitFlagName} {
+		assert.True(t, promote.Flags().Lookup(flag) != nil, "promote command should have %s flag", flag)
+	}
+}
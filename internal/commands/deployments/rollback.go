@@ -0,0 +1,192 @@
+package deployments
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command for rolling back environments
+	rollback = &cobra.Command{
+		Use:   constants.RollbackCmdUse,
+		Short: constants.RollbackCmdShort,
+		Long: `Roll back an environment by redeploying the deployment set of a previous deployment.
+The target is the deployment given with --to, or the deployment --steps deployments before the
+current one (the previous deployment by default). Only successful deployments can be rolled back
+to, so failed deployments are not counted by --steps. The differences between the current and the
+target deployment set are shown and the environment ID must be typed to confirm. Use --yes to
+skip the confirmation in automation, and --dry-run to print the request without sending it.
+With --wait the command waits until the deployment has finished and fails, after summarizing
+its errors, if the deployment failed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, env, err := appEnv(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Get flags
+			to, err := cmd.Flags().GetString(constants.ToFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get to flag: %w", err)
+			}
+
+			steps, err := cmd.Flags().GetInt(constants.StepsFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get steps flag: %w", err)
+			}
+
+			comment, err := cmd.Flags().GetString(constants.CommentFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get comment flag: %w", err)
+			}
+
+			yes, err := cmd.Flags().GetBool(constants.YesFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get yes flag: %w", err)
+			}
+
+			dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Validate input before calling the API
+			if to != "" && cmd.Flags().Changed(constants.StepsFlagName) {
+				return clierrors.Validationf("--%s and --%s cannot be used together", constants.ToFlagName, constants.StepsFlagName)
+			}
+			if steps < 1 {
+				return clierrors.Validationf("--%s must be at least 1", constants.StepsFlagName)
+			}
+
+			// Make sure the rollback can be confirmed before looking anything up
+			confirmer := prompt.NewConfirmer(cmd.InOrStdin(), cmd.ErrOrStderr(), yes)
+			if !dryRun {
+				if err := confirmer.Check("roll back environments"); err != nil {
+					return err
+				}
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			environment, err := client.GetEnv(app, env)
+			if err != nil {
+				return fmt.Errorf("failed to get env %s: %w", env, err)
+			}
+			if environment.LastDeploy == nil {
+				return fmt.Errorf("env %s has never been deployed", env)
+			}
+			current := environment.LastDeploy
+
+			var target *humanitec.Deployment
+			if to != "" {
+				if target, err = client.GetDeployment(app, env, to); err != nil {
+					return fmt.Errorf("failed to get deployment %s: %w", to, err)
+				}
+				if target.Status != humanitec.DeploymentSucceeded {
+					return clierrors.Validationf("deployment %s is %s, only %s deployments can be rolled back to", target.ID, target.Status, humanitec.DeploymentSucceeded)
+				}
+			} else {
+				history, err := client.GetDeployments(app, env)
+				if err != nil {
+					return fmt.Errorf("failed to list deployments: %w", err)
+				}
+				previous := previousDeployments(history, current.ID)
+				if steps > len(previous) {
+					return clierrors.Validationf("env %s has only %d previous successful deployments", env, len(previous))
+				}
+				target = &previous[steps-1]
+			}
+			if target.SetID == current.SetID {
+				return clierrors.Validationf("deployment %s deployed the current deployment set %s of env %s, nothing to roll back", target.ID, current.SetID, env)
+			}
+
+			if comment == "" {
+				comment = fmt.Sprintf("Rollback to deployment %s", target.ID)
+			}
+			req := humanitec.DeploymentRequest{SetID: target.SetID, Comment: comment}
+
+			// Print the request instead of sending it on a dry run
+			if dryRun {
+				return apps.PrintRequests(cmd, []humanitec.Request{humanitec.CreateDeploymentRequest(org, app, env, req)}, outputFormat)
+			}
+
+			// Show what will change and ask for confirmation
+			diff, err := setDiff(client, app, current.SetID, target.SetID)
+			if err != nil {
+				return err
+			}
+			out := cmd.ErrOrStderr()
+			fmt.Fprintf(out, "Env %s of application %s will be rolled back from deployment %s to deployment %s:\n", env, app, current.ID, target.ID)
+			fmt.Fprint(out, diff)
+
+			confirmed, err := confirmer.ConfirmByTyping(env)
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				return prompt.ErrAborted
+			}
+
+			return Deploy(cmd, client, app, env, req, outputFormat)
+		},
+	}
+)
+
+// previousDeployments returns the successful deployments that precede the deployment with
+// the given ID in the history, newest first
+func previousDeployments(history []humanitec.Deployment, currentID string) []humanitec.Deployment {
+	for i := range history {
+		if history[i].ID == currentID {
+			history = history[i+1:]
+			break
+		}
+	}
+
+	var previous []humanitec.Deployment
+	for _, deployment := range history {
+		if deployment.Status == humanitec.DeploymentSucceeded {
+			previous = append(previous, deployment)
+		}
+	}
+	return previous
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(rollback)
+	DeployFlagSet()(rollback)
+
+	// Add command-specific flags
+	rollback.Flags().String(constants.ToFlagName, "", constants.ToDeploymentFlagHelp)
+	rollback.Flags().Int(constants.StepsFlagName, 1, constants.StepsFlagHelp)
+	rollback.Flags().BoolP(constants.YesFlagName, constants.YesFlagShort, false, constants.YesFlagHelp)
+	rollback.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
+}
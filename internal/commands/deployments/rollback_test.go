@@ -0,0 +1,202 @@
+package deployments
+
+import (
+	"io"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// newRollbackMockClient returns a mock client for env production of test-app, whose last
+// deployment deploy-3 failed to deploy set-2 after two successful deployments of set-1
+func newRollbackMockClient() *test.MockClient {
+	deployments := []humanitec.Deployment{
+		{ID: "deploy-3", EnvID: "production", SetID: "set-2", Status: "failed"},
+		{ID: "deploy-2", EnvID: "production", SetID: "set-1", Status: "succeeded"},
+		{ID: "deploy-1", EnvID: "production", SetID: "set-1", Status: "succeeded"},
+	}
+	return &test.MockClient{
+		Envs:        []humanitec.Environment{{ID: "production", LastDeploy: &deployments[0]}},
+		Deployments: deployments,
+		Sets: map[string]*humanitec.DeploymentSet{
+			"set-1": {ID: "set-1", Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.0"}}},
+			"set-2": {ID: "set-2", Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.1"}}},
+		},
+	}
+}
+
+// TestRollbackCommandExecution verifies that rollback redeploys the set of a previous deployment.
+func TestRollbackCommandExecution(t *testing.T) {
+	flags := func(extra map[string]string) map[string]string {
+		f := map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "production"}
+		for k, v := range extra {
+			f[k] = v
+		}
+		return f
+	}
+
+	testCases := []struct {
+		name            string
+		flags           map[string]string
+		expectedOutput  string
+		expectedRequest humanitec.DeploymentRequest
+		expectedErr     int
+	}{
+		{
+			name:            "previous deployment",
+			flags:           flags(map[string]string{constants.YesFlagName: "true", constants.OutputFlagName: "ndjson"}),
+			expectedOutput:  "{\"id\":\"deploy-production\",\"env_id\":\"production\",\"set_id\":\"set-1\",\"comment\":\"Rollback to deployment deploy-2\",\"status\":\"pending\"}\n",
+			expectedRequest: humanitec.DeploymentRequest{SetID: "set-1", Comment: "Rollback to deployment deploy-2"},
+		},
+		{
+			name:            "to deployment with comment",
+			flags:           flags(map[string]string{constants.ToFlagName: "deploy-1", constants.CommentFlagName: "Revert api 1.1", constants.YesFlagName: "true", constants.OutputFlagName: "ndjson"}),
+			expectedOutput:  "{\"id\":\"deploy-production\",\"env_id\":\"production\",\"set_id\":\"set-1\",\"comment\":\"Revert api 1.1\",\"status\":\"pending\"}\n",
+			expectedRequest: humanitec.DeploymentRequest{SetID: "set-1", Comment: "Revert api 1.1"},
+		},
+		{
+			name:           "dry run",
+			flags:          flags(map[string]string{constants.StepsFlagName: "2", constants.DryRunFlagName: "true", constants.OrgFlagName: "test-org"}),
+			expectedOutput: "DRY RUN: POST /orgs/test-org/apps/test-app/envs/production/deploys\n{\n  \"set_id\": \"set-1\",\n  \"comment\": \"Rollback to deployment deploy-1\"\n}\n",
+		},
+		{
+			name:        "too many steps",
+			flags:       flags(map[string]string{constants.StepsFlagName: "3", constants.YesFlagName: "true"}),
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "deployment not found",
+			flags:       flags(map[string]string{constants.ToFlagName: "deploy-9", constants.YesFlagName: "true"}),
+			expectedErr: clierrors.ExitNotFound,
+		},
+		{
+			name:        "to and steps",
+			flags:       flags(map[string]string{constants.ToFlagName: "deploy-1", constants.StepsFlagName: "1", constants.YesFlagName: "true"}),
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "requires confirmation",
+			flags:       flags(nil),
+			expectedErr: clierrors.ExitValidation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := newRollbackMockClient()
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommand(t, rollback, rollback, nil, tt.flags)
+			if tt.expectedErr != 0 {
+				assert.Equal(t, tt.expectedErr, clierrors.ExitCode(err), "unexpected error: %v", err)
+				assert.Empty(t, mockClient.CreatedDeployments)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, got)
+			if tt.expectedRequest.SetID != "" {
+				assert.Equal(t, []humanitec.DeploymentRequest{tt.expectedRequest}, mockClient.CreatedDeployments)
+			}
+		})
+	}
+}
+
+// TestRollbackNothingToDo verifies that rolling back to the current set is refused.
+func TestRollbackNothingToDo(t *testing.T) {
+	mockClient := newRollbackMockClient()
+	mockClient.Deployments = mockClient.Deployments[1:]
+	mockClient.Envs[0].LastDeploy = &mockClient.Deployments[0]
+	test.SetupMockClient(t, mockClient)
+
+	_, err := test.ExecuteCommand(t, rollback, rollback, nil, map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "production", constants.YesFlagName: "true"})
+	assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(err))
+	assert.Empty(t, mockClient.CreatedDeployments)
+}
+
+// TestRollbackFailedDeployments verifies that failed deployments are not counted by --steps
+// and cannot be rolled back to with --to.
+func TestRollbackFailedDeployments(t *testing.T) {
+	mockClient := newRollbackMockClient()
+	mockClient.Deployments = append([]humanitec.Deployment{{ID: "deploy-4", EnvID: "production", SetID: "set-3", Status: "succeeded"}}, mockClient.Deployments...)
+	mockClient.Envs[0].LastDeploy = &mockClient.Deployments[0]
+	mockClient.Sets["set-3"] = &humanitec.DeploymentSet{ID: "set-3"}
+	test.SetupMockClient(t, mockClient)
+	flags := map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "production", constants.YesFlagName: "true"}
+
+	_, err := test.ExecuteCommand(t, rollback, rollback, nil, flags)
+	assert.NoError(t, err)
+	assert.Equal(t, []humanitec.DeploymentRequest{{SetID: "set-1", Comment: "Rollback to deployment deploy-2"}}, mockClient.CreatedDeployments)
+
+	mockClient.CreatedDeployments = nil
+	flags[constants.ToFlagName] = "deploy-3"
+	_, err = test.ExecuteCommand(t, rollback, rollback, nil, flags)
+	assert.EqualError(t, err, "deployment deploy-3 is failed, only succeeded deployments can be rolled back to")
+	assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(err))
+	assert.Empty(t, mockClient.CreatedDeployments)
+}
+
+// TestRollbackWait verifies that rollback --wait fails when the rollback deployment fails.
+func TestRollbackWait(t *testing.T) {
+	mockClient := newRollbackMockClient()
+	mockClient.Deployments = append(mockClient.Deployments, humanitec.Deployment{ID: "deploy-production", EnvID: "production", SetID: "set-1"})
+	mockClient.DeploymentStatuses = []string{humanitec.DeploymentInProgress, humanitec.DeploymentFailed}
+	test.SetupMockClient(t, mockClient)
+
+	got, err := test.ExecuteCommand(t, rollback, rollback, nil, map[string]string{
+		constants.AppFlagName: "test-app", constants.EnvFlagName: "production", constants.YesFlagName: "true",
+		constants.WaitFlagName: "true", constants.IntervalFlagName: "1ms", constants.OutputFlagName: "ndjson",
+	})
+	assert.EqualError(t, err, "deployment deploy-production to env production failed")
+	assert.Equal(t, "{\"id\":\"deploy-production\",\"env_id\":\"production\",\"set_id\":\"set-1\",\"status\":\"failed\"}\n", got)
+}
+
+// TestRollbackConfirmation verifies that the set diff is shown and the environment ID must be
+// typed to confirm a rollback.
+func TestRollbackConfirmation(t *testing.T) {
+	isInteractive := prompt.IsInteractive
+	prompt.IsInteractive = func(io.Reader) bool { return true }
+	defer func() { prompt.IsInteractive = isInteractive }()
+
+	mockClient := newRollbackMockClient()
+	test.SetupMockClient(t, mockClient)
+	flags := map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "production"}
+
+	_, err := test.ExecuteCommandWithInput(t, rollback, rollback, nil, flags, "yes\n")
+	assert.ErrorIs(t, err, prompt.ErrAborted)
+	assert.Empty(t, mockClient.CreatedDeployments)
+
+	_, err = test.ExecuteCommandWithInput(t, rollback, rollback, nil, flags, "production\n")
+	assert.NoError(t, err)
+	assert.Len(t, mockClient.CreatedDeployments, 1)
+}
+
+// TestSetDiff verifies that the set diff shown before a rollback summarizes the changed
+// workloads and compares the set contents.
+func TestSetDiff(t *testing.T) {
+	mockClient := newRollbackMockClient()
+
+	diff, err := setDiff(mockClient, "test-app", "set-2", "set-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Workloads:\n  ~ api\n\n--- set/set-2\n+++ set/set-1\n@@ -1,3 +1,3 @@\n modules:\n     api:\n-        image: api:1.1\n+        image: api:1.0\n", diff)
+
+	diff, err = setDiff(mockClient, "test-app", "set-1", "set-1")
+	assert.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
+// TestRollbackCommandConfiguration verifies that the rollback command is properly configured
+// with the correct name, description, and flags.
+func TestRollbackCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.RollbackCmdUse, rollback.Name(), "rollback command should have correct use")
+	assert.Equal(t, constants.RollbackCmdShort, rollback.Short, "rollback command should have correct short description")
+
+	for _, flag := range []string{constants.ToFlagName, constants.StepsFlagName, constants.YesFlagName, constants.DryRunFlagName,
+		constants.CommentFlagName, constants.WaitFlagName} {
+		assert.True(t, rollback.Flags().Lookup(flag) != nil, "rollback command should have %s flag", flag)
+	}
+}
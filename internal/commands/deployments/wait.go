@@ -0,0 +1,118 @@
+package deployments
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// conditionStatus is the prefix of the conditions supported by wait deployment
+const conditionStatus = "status="
+
+// statuses lists the deployment statuses that can be waited for
+var statuses = []string{humanitec.DeploymentPending, humanitec.DeploymentInProgress, humanitec.DeploymentSucceeded, humanitec.DeploymentFailed}
+
+var (
+	// Subcommand for waiting on deployments
+	wait = &cobra.Command{
+		Use:   constants.DeploymentCmdUse + " <id>",
+		Short: constants.DeploymentCmdShort,
+		Long: `Wait until a deployment reaches a status.
+The condition has the form status=<status>, e.g. --for=status=succeeded. Status changes are
+printed to standard error. The command fails as soon as the deployment finishes with a
+different status, or with exit code 7 if the status is not reached within --timeout.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, env, err := appEnv(cmd)
+			if err != nil {
+				return err
+			}
+
+			condition, err := cmd.Flags().GetString(constants.ForFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get for flag: %w", err)
+			}
+			status, ok := strings.CutPrefix(condition, conditionStatus)
+			if !ok {
+				return clierrors.Validationf("unsupported condition: %s. Supported conditions: %s<status>", condition, conditionStatus)
+			}
+			if !slices.Contains(statuses, status) {
+				return clierrors.Validationf("unsupported status: %s. Supported statuses: %s", status, strings.Join(statuses, ", "))
+			}
+
+			timeout, interval, err := apps.WaitTimings(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			id := args[0]
+			_, err = waitForDeployment(client, app, env, id, timeout, interval, cmd.ErrOrStderr(), func(d *humanitec.Deployment) (bool, error) {
+				if d.Status == status {
+					return true, nil
+				}
+				// A finished deployment will not change its status any more
+				if d.IsDone() {
+					return false, fmt.Errorf("deployment finished with status %s", d.Status)
+				}
+				return false, nil
+			})
+			if err != nil {
+				return fmt.Errorf("failed waiting for deployment %s to be %s: %w", id, status, err)
+			}
+
+			// Print output, highlighting deployments that succeeded
+			format := output.FormatMessage
+			if status == humanitec.DeploymentSucceeded {
+				format = output.FormatSuccess
+			}
+			formatted, err := format(fmt.Sprintf("Deployment %s %s", id, status), outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(wait)
+	apps.WaitFlagSet()(wait)
+
+	// Add command-specific flags
+	wait.Flags().String(constants.ForFlagName, "", constants.ForFlagHelp+" ("+conditionStatus+"<status>)")
+
+	// Mark required flags
+	wait.MarkFlagRequired(constants.ForFlagName)
+}
@@ -0,0 +1,102 @@
+package deployments
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWaitDeploymentCommandExecution verifies that wait deployment returns once the status is
+// reached and fails when the deployment finishes with another status or takes too long.
+func TestWaitDeploymentCommandExecution(t *testing.T) {
+	flags := func(condition, timeout string) map[string]string {
+		return map[string]string{
+			constants.AppFlagName:      "test-app",
+			constants.EnvFlagName:      "development",
+			constants.ForFlagName:      condition,
+			constants.IntervalFlagName: "5ms",
+			constants.TimeoutFlagName:  timeout,
+		}
+	}
+	mockClient := func(statuses ...string) *test.MockClient {
+		return &test.MockClient{
+			Deployments:        []humanitec.Deployment{{ID: "deploy-1", EnvID: "development", SetID: "set-1"}},
+			DeploymentStatuses: statuses,
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		mockClient     *test.MockClient
+		expectedOutput string
+		expectedExit   int
+	}{
+		{
+			name:           "succeeded",
+			args:           []string{"deploy-1"},
+			flags:          flags("status=succeeded", "1s"),
+			mockClient:     mockClient("pending", "in progress", "succeeded"),
+			expectedOutput: "Deployment deploy-1 succeeded\n",
+		},
+		{
+			name:           "in progress",
+			args:           []string{"deploy-1"},
+			flags:          flags("status=in progress", "1s"),
+			mockClient:     mockClient("pending", "in progress"),
+			expectedOutput: "Deployment deploy-1 in progress\n",
+		},
+		{
+			name:         "finished with another status",
+			args:         []string{"deploy-1"},
+			flags:        flags("status=succeeded", "1s"),
+			mockClient:   mockClient("in progress", "failed"),
+			expectedExit: clierrors.ExitError,
+		},
+		{
+			name:         "timeout",
+			args:         []string{"deploy-1"},
+			flags:        flags("status=succeeded", "20ms"),
+			mockClient:   mockClient("in progress"),
+			expectedExit: clierrors.ExitTimeout,
+		},
+		{
+			name:         "deployment not found",
+			args:         []string{"deploy-2"},
+			flags:        flags("status=succeeded", "1s"),
+			mockClient:   mockClient(),
+			expectedExit: clierrors.ExitNotFound,
+		},
+		{
+			name:         "unsupported condition",
+			args:         []string{"deploy-1"},
+			flags:        flags("ready", "1s"),
+			mockClient:   mockClient(),
+			expectedExit: clierrors.ExitValidation,
+		},
+		{
+			name:         "unsupported status",
+			args:         []string{"deploy-1"},
+			flags:        flags("status=done", "1s"),
+			mockClient:   mockClient(),
+			expectedExit: clierrors.ExitValidation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			test.SetupMockClient(t, tt.mockClient)
+
+			got, err := test.ExecuteCommand(t, wait, wait, tt.args, tt.flags)
+			assert.Equal(t, tt.expectedExit, clierrors.ExitCode(err), "unexpected error: %v", err)
+			if tt.expectedExit == clierrors.ExitOK {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
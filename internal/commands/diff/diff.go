@@ -0,0 +1,181 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/diffutil"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/manifest"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var diff = &cobra.Command{
+	Use:   constants.DiffCmdUse,
+	Short: constants.DiffCmdShort,
+	Long: `Show what apply would change.
+Application manifests are compared with the live state of the organization and the differences
+are printed as a unified diff, or as a JSON Patch from the live to the desired state with
+--output json|yaml|ndjson. The command exits with status 1 when differences exist and 0 when
+the live state matches the manifests, so it can gate CI pipelines. Status 1 is also used for
+unclassified errors; unlike differences, those print an error message, or an Error envelope
+with --output json|yaml|ndjson.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Get flags
+		filename, err := cmd.Flags().GetString(constants.FilenameFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get filename flag: %w", err)
+		}
+
+		prune, err := cmd.Flags().GetBool(constants.PruneFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get prune flag: %w", err)
+		}
+
+		outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get output format flag: %w", err)
+		}
+
+		// Validate output format
+		outputFormat, err := output.ValidateFormat(outputFormatStr)
+		if err != nil {
+			return fmt.Errorf("invalid output format: %w", err)
+		}
+
+		manifests, err := manifest.Load(filename, cmd.InOrStdin())
+		if err != nil {
+			return err
+		}
+
+		// Get organization ID from the flag or config
+		org, err := apps.OrgID(cmd)
+		if err != nil {
+			return err
+		}
+		token := config.GetConfig().HumanitecToken
+
+		// Create Humanitec client
+		client := humanitec.NewClient(token, org)
+
+		plan, err := manifest.Plan(client, manifests, prune)
+		if err != nil {
+			return fmt.Errorf("failed to compute diff: %w", err)
+		}
+
+		changed := false
+		for _, action := range plan {
+			if action.Type != manifest.ActionNone {
+				changed = true
+			}
+		}
+
+		var formatted string
+		if output.IsMachineFormat(outputFormat) {
+			formatted, err = formatPatch(plan, outputFormat)
+		} else {
+			formatted, err = formatUnified(plan)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to format output: %w", err)
+		}
+		fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+		if changed {
+			cmd.SilenceUsage = true
+			return clierrors.NewSilent(clierrors.ErrDifferences)
+		}
+		return nil
+	},
+}
+
+// states returns the live and desired manifest of a planned action; a missing side is nil
+func states(action manifest.Action) (live, desired *manifest.AppManifest) {
+	if action.Live != nil {
+		m := manifest.FromApp(action.Live)
+		live = &m
+	}
+	return live, action.Desired
+}
+
+// formatUnified renders every changed application as a unified diff of its YAML manifests
+func formatUnified(plan []manifest.Action) (string, error) {
+	var sb strings.Builder
+	for _, action := range plan {
+		if action.Type == manifest.ActionNone {
+			continue
+		}
+
+		live, desired := states(action)
+		from, err := toYAML(live)
+		if err != nil {
+			return "", err
+		}
+		to, err := toYAML(desired)
+		if err != nil {
+			return "", err
+		}
+
+		unified, err := diffutil.Unified(from, to, "live/"+action.ID, "desired/"+action.ID)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(output.FormatUnifiedDiff(unified))
+	}
+	return sb.String(), nil
+}
+
+// formatPatch renders the plan as a JSON Patch from the live to the desired state,
+// where both states are documents keyed by application ID
+func formatPatch(plan []manifest.Action, format output.Format) (string, error) {
+	liveDoc := map[string]*manifest.AppManifest{}
+	desiredDoc := map[string]*manifest.AppManifest{}
+	for _, action := range plan {
+		live, desired := states(action)
+		if live != nil {
+			liveDoc[action.ID] = live
+		}
+		if desired != nil {
+			desiredDoc[action.ID] = desired
+		}
+	}
+
+	ops, err := diffutil.JSONPatch(liveDoc, desiredDoc)
+	if err != nil {
+		return "", err
+	}
+	return output.FormatPatch(ops, format)
+}
+
+// toYAML renders a manifest as YAML, or "" if it is nil
+func toYAML(m *manifest.AppManifest) (string, error) {
+	if m == nil {
+		return "", nil
+	}
+	data, err := yaml.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal to YAML: %w", err)
+	}
+	return string(data), nil
+}
+
+// Command returns the diff command
+func Command() *cobra.Command {
+	return diff
+}
+
+func init() {
+	// Add common flags
+	apps.CommonFlagSet()(diff)
+
+	// Add command-specific flags
+	diff.Flags().StringP(constants.FilenameFlagName, constants.FilenameFlagShort, "", constants.FilenameFlagHelp)
+	diff.Flags().Bool(constants.PruneFlagName, false, constants.PruneFlagHelp)
+	diff.MarkFlagRequired(constants.FilenameFlagName)
+}
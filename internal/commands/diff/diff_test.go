@@ -0,0 +1,79 @@
+package diff
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDiffCommandExecution verifies that diff prints the changes apply would make
+// and exits with the differences code only when the live state differs.
+func TestDiffCommandExecution(t *testing.T) {
+	dir := t.TempDir()
+	changed := filepath.Join(dir, "changed.yaml")
+	unchanged := filepath.Join(dir, "unchanged.yaml")
+	if err := os.WriteFile(changed, []byte("kind: App\nid: test-app\nname: New Name\n---\nkind: App\nid: new-app\nname: New App\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+	if err := os.WriteFile(unchanged, []byte("kind: App\nid: test-app\nname: Test App\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+
+	testCases := []struct {
+		name           string
+		flags          map[string]string
+		expectedOutput string
+		expectChanges  bool
+	}{
+		{
+			name:          "unified diff",
+			flags:         map[string]string{constants.FilenameFlagName: changed},
+			expectChanges: true,
+			expectedOutput: "--- live/test-app\n+++ desired/test-app\n@@ -1,3 +1,3 @@\n kind: App\n id: test-app\n-name: Test App\n+name: New Name\n" +
+				"--- live/new-app\n+++ desired/new-app\n@@ -0,0 +1,3 @@\n+kind: App\n+id: new-app\n+name: New App\n",
+		},
+		{
+			name:          "json patch",
+			flags:         map[string]string{constants.FilenameFlagName: changed, constants.OutputFlagName: "ndjson"},
+			expectChanges: true,
+			expectedOutput: "{\"op\":\"add\",\"path\":\"/new-app\",\"value\":{\"id\":\"new-app\",\"kind\":\"App\",\"name\":\"New App\"}}\n" +
+				"{\"op\":\"replace\",\"path\":\"/test-app/name\",\"value\":\"New Name\"}\n",
+		},
+		{
+			name:           "no differences",
+			flags:          map[string]string{constants.FilenameFlagName: unchanged},
+			expectedOutput: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			test.SetupMockClient(t, &test.MockClient{
+				App:       &humanitec.App{ID: "test-app", Name: "Test App"},
+				AppErrors: map[string]error{"new-app": &humanitec.APIError{StatusCode: 404}},
+			})
+
+			got, err := test.ExecuteCommand(t, diff, diff, []string{}, tc.flags)
+			if tc.expectChanges {
+				assert.ErrorIs(t, err, clierrors.ErrDifferences)
+				assert.Equal(t, clierrors.ExitDifferences, clierrors.ExitCode(err))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedOutput, got)
+		})
+	}
+}
+
+// TestDiffCommandConfiguration verifies that the diff command is properly configured.
+func TestDiffCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.DiffCmdUse, diff.Use)
+	assert.Equal(t, constants.DiffCmdShort, diff.Short)
+	assert.True(t, diff.Flags().Lookup(constants.FilenameFlagName) != nil, "diff command should have filename flag")
+}
@@ -0,0 +1,47 @@
+package envs
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+// CommonFlagSet returns a function that adds the common flags and the required --app flag to a command
+func CommonFlagSet() func(*cobra.Command) {
+	return func(cmd *cobra.Command) {
+		apps.CommonFlagSet()(cmd)
+		cmd.Flags().StringP(constants.AppFlagName, constants.AppFlagShort, "", constants.AppFlagHelp)
+		cmd.MarkFlagRequired(constants.AppFlagName)
+	}
+}
+
+// appID returns the validated application ID given with --app
+func appID(cmd *cobra.Command) (string, error) {
+	app, err := cmd.Flags().GetString(constants.AppFlagName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get app flag: %w", err)
+	}
+	if err := validation.ID(validation.App, app); err != nil {
+		return "", err
+	}
+	return app, nil
+}
+
+// envID returns the environment ID given with --id or as the only positional argument
+func envID(cmd *cobra.Command, args []string) (string, error) {
+	id, err := cmd.Flags().GetString(constants.IDFlagName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get id flag: %w", err)
+	}
+	if len(args) > 0 {
+		if id != "" && id != args[0] {
+			return "", clierrors.Validationf("environment ID given both as argument %q and with --%s %q", args[0], constants.IDFlagName, id)
+		}
+		id = args[0]
+	}
+	return id, nil
+}
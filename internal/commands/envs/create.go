@@ -0,0 +1,161 @@
+package envs
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for creating environments
+	create = &cobra.Command{
+		Use:   constants.EnvCmdUse,
+		Short: constants.EnvCmdShort,
+		Long: `Create a new environment in an application.
+The environment requires an ID and one of the organization's environment types; the name
+defaults to the ID.
+With --from-env the new environment starts from the current deployment of another
+environment of the same application instead of being empty.
+With --dry-run the request is validated and printed instead of being sent.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := appID(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Get flags
+			id, err := cmd.Flags().GetString(constants.IDFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get id flag: %w", err)
+			}
+
+			name, err := cmd.Flags().GetString(constants.NameFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get name flag: %w", err)
+			}
+
+			envType, err := cmd.Flags().GetString(constants.TypeFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get type flag: %w", err)
+			}
+
+			fromEnv, err := cmd.Flags().GetString(constants.FromEnvFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get from-env flag: %w", err)
+			}
+
+			dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Validate input before calling the API
+			if err := validation.ID(validation.Environment, id); err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(constants.NameFlagName) {
+				if err := validation.Name(name); err != nil {
+					return err
+				}
+			} else {
+				name = id
+			}
+			if fromEnv != "" {
+				if err := validation.ID(validation.Environment, fromEnv); err != nil {
+					return err
+				}
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			// Environment types are customized per organization, so check the type exists
+			envTypes, err := client.GetEnvTypes()
+			if err != nil {
+				return fmt.Errorf("failed to list env types: %w", err)
+			}
+			typeIDs := make([]string, 0, len(envTypes))
+			for _, t := range envTypes {
+				typeIDs = append(typeIDs, t.ID)
+			}
+			if err := validation.OneOf(validation.EnvType, envType, typeIDs); err != nil {
+				return err
+			}
+
+			env := humanitec.Environment{ID: id, Name: name, Type: envType}
+
+			// Start from the deployment currently active in the source environment
+			if fromEnv != "" {
+				source, err := client.GetEnv(app, fromEnv)
+				if err != nil {
+					return fmt.Errorf("failed to get env %s: %w", fromEnv, err)
+				}
+				if source.LastDeploy == nil {
+					return clierrors.Validationf("environment %s has not been deployed yet, so there is nothing to copy", fromEnv)
+				}
+				env.FromDeployID = source.LastDeploy.ID
+			}
+
+			// Print the request instead of sending it on a dry run
+			if dryRun {
+				return apps.PrintRequests(cmd, []humanitec.Request{humanitec.CreateEnvRequest(org, app, env)}, outputFormat)
+			}
+
+			// Create environment
+			created, err := client.CreateEnv(app, env)
+			if err != nil {
+				return fmt.Errorf("failed to create env: %w", err)
+			}
+
+			// Print output
+			formatted, err := output.FormatEnv(created, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(create)
+
+	// Add command-specific flags
+	create.Flags().StringP(constants.IDFlagName, constants.IDFlagShort, "", constants.EnvIDFlagHelp)
+	create.Flags().StringP(constants.NameFlagName, constants.NameFlagShort, "", constants.EnvNameFlagHelp)
+	create.Flags().StringP(constants.TypeFlagName, constants.TypeFlagShort, "", constants.TypeFlagHelp)
+	create.Flags().String(constants.FromEnvFlagName, "", constants.FromEnvFlagHelp)
+	create.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
+
+	// Mark required flags
+	create.MarkFlagRequired(constants.IDFlagName)
+	create.MarkFlagRequired(constants.TypeFlagName)
+}
@@ -0,0 +1,140 @@
+package envs
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCreateEnvCommandExecution verifies the create env command's runtime behavior for
+// various input combinations and output formats.
+func TestCreateEnvCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		flags          map[string]string
+		expectedOutput string
+		expectError    bool
+	}{
+		{
+			name:           "name defaults to id - table format",
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.IDFlagName: "qa", constants.TypeFlagName: "development"},
+			expectedOutput: "ID\tNAME\tTYPE\n--\t----\t----\nqa\tqa\tdevelopment\n",
+		},
+		{
+			name:           "with name - json format",
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.IDFlagName: "qa", constants.NameFlagName: "QA", constants.TypeFlagName: "development", constants.OutputFlagName: "json"},
+			expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"Environment\",\n  \"item\": {\n    \"id\": \"qa\",\n    \"name\": \"QA\",\n    \"type\": \"development\"\n  }\n}\n",
+		},
+		{
+			name:           "from env",
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.IDFlagName: "qa", constants.TypeFlagName: "development", constants.FromEnvFlagName: "development", constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"id\":\"qa\",\"name\":\"qa\",\"type\":\"development\",\"from_deploy_id\":\"deploy-1\"}\n",
+		},
+		{
+			name:        "from env without deployments",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.IDFlagName: "qa", constants.TypeFlagName: "development", constants.FromEnvFlagName: "staging"},
+			expectError: true,
+		},
+		{
+			name:        "invalid id",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.IDFlagName: "QA_Env", constants.TypeFlagName: "development"},
+			expectError: true,
+		},
+		{
+			name:        "missing type flag",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.IDFlagName: "qa"},
+			expectError: true,
+		},
+	}
+
+	mockClient := &test.MockClient{
+		Envs: []humanitec.Environment{
+			{ID: "development", Name: "Development", Type: "development", CreatedAt: "2024-01-02T03:04:05Z", CreatedBy: "user-1", LastDeploy: &humanitec.Deployment{ID: "deploy-1", Status: "succeeded"}},
+			{ID: "staging", Name: "Staging", Type: "staging"},
+		},
+		EnvTypes: []humanitec.EnvironmentType{{ID: "development"}, {ID: "staging"}, {ID: "production"}},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(t, create, create, []string{}, tt.flags)
+			if (err != nil) != tt.expectError {
+				t.Errorf("create.Execute() error = %v, wantErr %v", err, tt.expectError)
+				return
+			}
+			if !tt.expectError {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
+
+// TestCreateEnvDryRun verifies that --dry-run prints the request without creating the environment.
+func TestCreateEnvDryRun(t *testing.T) {
+	mockClient := &test.MockClient{
+		Envs: []humanitec.Environment{
+			{ID: "development", Name: "Development", Type: "development", CreatedAt: "2024-01-02T03:04:05Z", CreatedBy: "user-1", LastDeploy: &humanitec.Deployment{ID: "deploy-1", Status: "succeeded"}},
+			{ID: "staging", Name: "Staging", Type: "staging"},
+		},
+		EnvTypes: []humanitec.EnvironmentType{{ID: "development"}, {ID: "staging"}, {ID: "production"}},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	got, err := test.ExecuteCommand(t, create, create, []string{}, map[string]string{
+		constants.AppFlagName:     "test-app",
+		constants.IDFlagName:      "qa",
+		constants.TypeFlagName:    "development",
+		constants.FromEnvFlagName: "development",
+		constants.DryRunFlagName:  "true",
+		constants.OrgFlagName:     "test-org",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "DRY RUN: POST /orgs/test-org/apps/test-app/envs\n{\n  \"id\": \"qa\",\n  \"name\": \"qa\",\n  \"type\": \"development\",\n  \"from_deploy_id\": \"deploy-1\"\n}\n", got)
+
+}
+
+// TestCreateEnvCommandConfiguration verifies that the create env command is properly configured
+// with the correct name, description, and flags.
+func TestCreateEnvCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.EnvCmdUse, create.Name(), "create command should have correct use")
+	assert.Equal(t, constants.EnvCmdShort, create.Short, "create command should have correct short description")
+
+	assert.True(t, create.Flags().Lookup(constants.TypeFlagName) != nil, "create command should have type flag")
+	assert.True(t, create.Flags().Lookup(constants.FromEnvFlagName) != nil, "create command should have from-env flag")
+}
+
+// TestCreateEnvUnknownType verifies that an unknown environment type is rejected with
+// the available types and a suggestion for typos.
+func TestCreateEnvUnknownType(t *testing.T) {
+	mockClient := &test.MockClient{
+		Envs: []humanitec.Environment{
+			{ID: "development", Name: "Development", Type: "development", CreatedAt: "2024-01-02T03:04:05Z", CreatedBy: "user-1", LastDeploy: &humanitec.Deployment{ID: "deploy-1", Status: "succeeded"}},
+			{ID: "staging", Name: "Staging", Type: "staging"},
+		},
+		EnvTypes: []humanitec.EnvironmentType{{ID: "development"}, {ID: "staging"}, {ID: "production"}},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	_, err := test.ExecuteCommand(t, create, create, []string{}, map[string]string{
+		constants.AppFlagName:  "test-app",
+		constants.IDFlagName:   "qa",
+		constants.TypeFlagName: "developmnet",
+	})
+
+	assert.EqualError(t, err, `unknown environment type "developmnet": must be one of development, staging, production (did you mean "development"?)`)
+	assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(err))
+
+	_, err = test.ExecuteCommand(t, create, create, []string{}, map[string]string{
+		constants.AppFlagName:  "test-app",
+		constants.IDFlagName:   "qa",
+		constants.TypeFlagName: "sandbox",
+	})
+
+	assert.EqualError(t, err, `unknown environment type "sandbox": must be one of development, staging, production`)
+}
@@ -0,0 +1,135 @@
+package envs
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var delete = &cobra.Command{
+	Use:   constants.EnvCmdUse + " [id]",
+	Short: constants.EnvCmdShort,
+	Long: `Delete an environment from an application.
+
+Before deleting, the environment and its last deployment are shown and the environment ID
+must be typed to confirm. Use --yes to skip the confirmation in automation, and --dry-run
+to print the request without sending it.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		app, err := appID(cmd)
+		if err != nil {
+			return err
+		}
+
+		id, err := envID(cmd, args)
+		if err != nil {
+			return err
+		}
+		if id == "" {
+			return clierrors.Validationf("an environment ID is required")
+		}
+		if err := validation.ID(validation.Environment, id); err != nil {
+			return err
+		}
+
+		outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get output format flag: %w", err)
+		}
+
+		// Validate output format
+		outputFormat, err := output.ValidateFormat(outputFormatStr)
+		if err != nil {
+			return fmt.Errorf("invalid output format: %w", err)
+		}
+
+		yes, err := cmd.Flags().GetBool(constants.YesFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get yes flag: %w", err)
+		}
+
+		dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get dry-run flag: %w", err)
+		}
+
+		// Get organization ID from the flag or config
+		org, err := apps.OrgID(cmd)
+		if err != nil {
+			return err
+		}
+		token := config.GetConfig().HumanitecToken
+
+		// Print the request instead of sending it on a dry run
+		if dryRun {
+			return apps.PrintRequests(cmd, []humanitec.Request{humanitec.DeleteEnvRequest(org, app, id)}, outputFormat)
+		}
+
+		// Create Humanitec client
+		client := humanitec.NewClient(token, org)
+
+		// Show what will be deleted and ask for confirmation
+		confirmer := prompt.NewConfirmer(cmd.InOrStdin(), cmd.ErrOrStderr(), yes)
+		if err := confirmer.Check("delete environments"); err != nil {
+			return err
+		}
+		if !yes {
+			env, err := client.GetEnv(app, id)
+			if err != nil {
+				return fmt.Errorf("failed to get env %s: %w", id, err)
+			}
+
+			lastDeploy := "<none>"
+			if env.LastDeploy != nil {
+				lastDeploy = fmt.Sprintf("%s (%s)", env.LastDeploy.ID, env.LastDeploy.Status)
+			}
+
+			out := cmd.ErrOrStderr()
+			fmt.Fprintf(out, "The following environment of application %s will be deleted:\n", app)
+			fmt.Fprintf(out, "  ID:              %s\n", env.ID)
+			fmt.Fprintf(out, "  Name:            %s\n", env.Name)
+			fmt.Fprintf(out, "  Type:            %s\n", env.Type)
+			fmt.Fprintf(out, "  Last deployment: %s\n", lastDeploy)
+
+			confirmed, err := confirmer.ConfirmByTyping(id)
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				return prompt.ErrAborted
+			}
+		}
+
+		// Delete environment
+		if err := client.DeleteEnv(app, id); err != nil {
+			return fmt.Errorf("failed to delete env: %w", err)
+		}
+
+		// Print output
+		formatted, err := output.FormatSuccess("Environment successfully deleted", outputFormat)
+		if err != nil {
+			return fmt.Errorf("failed to format output: %w", err)
+		}
+		fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+		return nil
+	},
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(delete)
+
+	// Add command-specific flags
+	delete.Flags().StringP(constants.IDFlagName, constants.IDFlagShort, "", constants.EnvIDFlagHelp)
+	delete.Flags().BoolP(constants.YesFlagName, constants.YesFlagShort, false, constants.YesFlagHelp)
+	delete.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
+}
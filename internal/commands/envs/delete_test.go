@@ -0,0 +1,111 @@
+package envs
+
+import (
+	"io"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeleteEnvCommandExecution verifies that the delete env command deletes an environment.
+func TestDeleteEnvCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		expectedOutput string
+		expectedErr    int
+	}{
+		{
+			name:           "delete with yes",
+			args:           []string{"staging"},
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.YesFlagName: "true"},
+			expectedOutput: "Environment successfully deleted\n",
+		},
+		{
+			name:           "delete with yes - json format",
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.IDFlagName: "staging", constants.YesFlagName: "true", constants.OutputFlagName: "json"},
+			expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"Message\",\n  \"item\": {\n    \"message\": \"Environment successfully deleted\"\n  }\n}\n",
+		},
+		{
+			name:           "dry run",
+			args:           []string{"staging"},
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.DryRunFlagName: "true", constants.OrgFlagName: "test-org"},
+			expectedOutput: "DRY RUN: DELETE /orgs/test-org/apps/test-app/envs/staging\n",
+		},
+		{
+			name:        "requires confirmation",
+			args:        []string{"staging"},
+			flags:       map[string]string{constants.AppFlagName: "test-app"},
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "env not found",
+			args:        []string{"production"},
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.YesFlagName: "true"},
+			expectedErr: clierrors.ExitNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &test.MockClient{
+				Envs: []humanitec.Environment{
+					{ID: "development", Name: "Development", Type: "development", CreatedAt: "2024-01-02T03:04:05Z", CreatedBy: "user-1", LastDeploy: &humanitec.Deployment{ID: "deploy-1", Status: "succeeded"}},
+					{ID: "staging", Name: "Staging", Type: "staging"},
+				},
+				EnvTypes: []humanitec.EnvironmentType{{ID: "development"}, {ID: "staging"}, {ID: "production"}},
+			}
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommand(t, delete, delete, tt.args, tt.flags)
+			if tt.expectedErr != 0 {
+				assert.Equal(t, tt.expectedErr, clierrors.ExitCode(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, got)
+		})
+	}
+}
+
+// TestDeleteEnvConfirmation verifies that the environment ID must be typed to confirm a deletion.
+func TestDeleteEnvConfirmation(t *testing.T) {
+	isInteractive := prompt.IsInteractive
+	prompt.IsInteractive = func(io.Reader) bool { return true }
+	defer func() { prompt.IsInteractive = isInteractive }()
+
+	mockClient := &test.MockClient{
+		Envs: []humanitec.Environment{
+			{ID: "development", Name: "Development", Type: "development", CreatedAt: "2024-01-02T03:04:05Z", CreatedBy: "user-1", LastDeploy: &humanitec.Deployment{ID: "deploy-1", Status: "succeeded"}},
+			{ID: "staging", Name: "Staging", Type: "staging"},
+		},
+		EnvTypes: []humanitec.EnvironmentType{{ID: "development"}, {ID: "staging"}, {ID: "production"}},
+	}
+	test.SetupMockClient(t, mockClient)
+	flags := map[string]string{constants.AppFlagName: "test-app"}
+
+	_, err := test.ExecuteCommandWithInput(t, delete, delete, []string{"development"}, flags, "yes\n")
+	assert.ErrorIs(t, err, prompt.ErrAborted)
+	assert.Empty(t, mockClient.DeletedEnvs)
+
+	got, err := test.ExecuteCommandWithInput(t, delete, delete, []string{"development"}, flags, "development\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "Environment successfully deleted\n", got)
+	assert.Equal(t, []string{"development"}, mockClient.DeletedEnvs)
+}
+
+// TestDeleteEnvCommandConfiguration verifies that the delete env command is properly configured
+// with the correct name, description, and flags.
+func TestDeleteEnvCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.EnvCmdUse, delete.Name(), "delete command should have correct use")
+	assert.Equal(t, constants.EnvCmdShort, delete.Short, "delete command should have correct short description")
+
+	assert.True(t, delete.Flags().Lookup(constants.YesFlagName) != nil, "delete command should have yes flag")
+	assert.True(t, delete.Flags().Lookup(constants.DryRunFlagName) != nil, "delete command should have dry-run flag")
+}
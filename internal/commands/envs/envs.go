@@ -0,0 +1,40 @@
+package envs
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// GetCommand returns the command for getting environments
+func GetCommand() *cobra.Command {
+	return get
+}
+
+// CreateCommand returns the command for creating environments
+func CreateCommand() *cobra.Command {
+	return create
+}
+
+// UpdateCommand returns the command for updating environments
+func UpdateCommand() *cobra.Command {
+	return update
+}
+
+// DeleteCommand returns the command for deleting environments
+func DeleteCommand() *cobra.Command {
+	return delete
+}
+
+// PauseCommand returns the command for pausing environments
+func PauseCommand() *cobra.Command {
+	return pause
+}
+
+// ResumeCommand returns the command for resuming environments
+func ResumeCommand() *cobra.Command {
+	return resume
+}
+
+// StatusCommand returns the command for showing the runtime status of an environment
+func StatusCommand() *cobra.Command {
+	return status
+}
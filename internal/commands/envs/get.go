@@ -0,0 +1,134 @@
+package envs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for getting environments
+	get = &cobra.Command{
+		Use:     constants.EnvsCmdUse + " [id]",
+		Aliases: []string{constants.EnvCmdUse},
+		Short:   constants.EnvsCmdShort,
+		Long: `List the environments of an application, or get a single environment by ID.
+Wide output adds creation details and the last deployment of each environment.
+With --watch the list is refreshed every --interval and changes are printed as ADDED,
+MODIFIED and DELETED events until interrupted. When writing a table to a terminal, the
+whole table is redrawn instead.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := appID(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := envID(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			watchChanges, interval, err := apps.WatchSettings(cmd)
+			if err != nil {
+				return err
+			}
+			if watchChanges && id != "" {
+				return clierrors.Validationf("--%s is only supported when listing all environments", constants.WatchFlagName)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			if watchChanges {
+				return watchEnvs(cmd, client, app, outputFormat, interval)
+			}
+
+			// If ID is provided, get single environment
+			if id != "" {
+				if err := validation.ID(validation.Environment, id); err != nil {
+					return err
+				}
+
+				env, err := client.GetEnv(app, id)
+				if err != nil {
+					return fmt.Errorf("failed to get env: %w", err)
+				}
+
+				// Print output
+				formatted, err := output.FormatEnv(env, outputFormat)
+				if err != nil {
+					return fmt.Errorf("failed to format output: %w", err)
+				}
+				fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+				return nil
+			}
+
+			// Otherwise, list all environments of the app
+			envs, err := client.GetEnvs(app)
+			if err != nil {
+				return fmt.Errorf("failed to list envs: %w", err)
+			}
+
+			// Print output
+			formatted, err := output.FormatEnvs(envs, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+// watchEnvs prints the environments of an application and then the changes to them until the
+// command is interrupted.
+func watchEnvs(cmd *cobra.Command, client humanitec.Client, app string, outputFormat output.Format, interval time.Duration) error {
+	list := func() ([]humanitec.Environment, error) {
+		return client.GetEnvs(app)
+	}
+
+	err := output.Watch(cmd.Context(), cmd.OutOrStdout(), interval, list,
+		func(env humanitec.Environment) string { return env.ID }, output.FormatEnvs, outputFormat)
+	if err != nil {
+		return fmt.Errorf("failed to list envs: %w", err)
+	}
+	return nil
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(get)
+	apps.WatchFlagSet()(get)
+
+	// Add command-specific flags
+	get.Flags().StringP(constants.IDFlagName, constants.IDFlagShort, "", constants.EnvIDFlagHelp)
+}
@@ -0,0 +1,163 @@
+package envs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGetEnvCommandExecution verifies that the get envs command lists the environments
+// of an application or gets a single environment, in every output format.
+func TestGetEnvCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		expectedOutput string
+		expectError    bool
+	}{
+		{
+			name:           "list envs - table format",
+			flags:          map[string]string{constants.AppFlagName: "test-app"},
+			expectedOutput: "ID\tNAME\tTYPE\n--\t----\t----\ndevelopment\tDevelopment\tdevelopment\nstaging\tStaging\tstaging\n",
+		},
+		{
+			name:  "list envs - wide format",
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.OutputFlagName: "wide"},
+			expectedOutput: "ID\tNAME\tTYPE\tCREATED AT\tCREATED BY\tLAST DEPLOYMENT\tSTATUS\n--\t----\t----\t----------\t----------\t---------------\t------\n" +
+				"development\tDevelopment\tdevelopment\t2024-01-02T03:04:05Z\tuser-1\tdeploy-1\tsucceeded\n" +
+				"staging\tStaging\tstaging\t<none>\t<none>\t<none>\t<none>\n",
+		},
+		{
+			name:           "list envs - ndjson format",
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"id\":\"development\",\"name\":\"Development\",\"type\":\"development\",\"created_at\":\"2024-01-02T03:04:05Z\",\"created_by\":\"user-1\",\"last_deploy\":{\"id\":\"deploy-1\",\"env_id\":\"\",\"set_id\":\"\",\"status\":\"succeeded\"}}\n{\"id\":\"staging\",\"name\":\"Staging\",\"type\":\"staging\"}\n",
+		},
+		{
+			name:           "get single env by argument - json format",
+			args:           []string{"staging"},
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.OutputFlagName: "json"},
+			expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"Environment\",\n  \"item\": {\n    \"id\": \"staging\",\n    \"name\": \"Staging\",\n    \"type\": \"staging\"\n  }\n}\n",
+		},
+		{
+			name:           "get single env by flag - yaml format",
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.IDFlagName: "staging", constants.OutputFlagName: "yaml"},
+			expectedOutput: "apiVersion: humctl-wrapper/v1\nkind: Environment\nitem:\n    id: staging\n    name: Staging\n    type: staging\n",
+		},
+		{
+			name:        "env not found",
+			args:        []string{"production"},
+			flags:       map[string]string{constants.AppFlagName: "test-app"},
+			expectError: true,
+		},
+		{
+			name:        "missing app flag",
+			flags:       map[string]string{},
+			expectError: true,
+		},
+		{
+			name:        "invalid app id",
+			flags:       map[string]string{constants.AppFlagName: "Test_App"},
+			expectError: true,
+		},
+	}
+
+	mockClient := &test.MockClient{
+		Envs: []humanitec.Environment{
+			{ID: "development", Name: "Development", Type: "development", CreatedAt: "2024-01-02T03:04:05Z", CreatedBy: "user-1", LastDeploy: &humanitec.Deployment{ID: "deploy-1", Status: "succeeded"}},
+			{ID: "staging", Name: "Staging", Type: "staging"},
+		},
+		EnvTypes: []humanitec.EnvironmentType{{ID: "development"}, {ID: "staging"}, {ID: "production"}},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(t, get, get, tt.args, tt.flags)
+			if (err != nil) != tt.expectError {
+				t.Errorf("get.Execute() error = %v, wantErr %v", err, tt.expectError)
+				return
+			}
+			if !tt.expectError {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
+
+// TestGetEnvsWatch verifies that --watch prints the initial environments as ADDED events
+// followed by the changes between listings.
+func TestGetEnvsWatch(t *testing.T) {
+	testCases := []struct {
+		name           string
+		format         string
+		expectedOutput string
+	}{
+		{
+			name:   "table format",
+			format: "table",
+			expectedOutput: "EVENT\tID\tNAME\tTYPE\n-----\t--\t----\t----\n" +
+				"ADDED\tdevelopment\tDevelopment\tdevelopment\n" +
+				"ADDED\tstaging\tStaging\tstaging\n" +
+				"MODIFIED\tstaging\tPre-production\tstaging\n" +
+				"DELETED\tdevelopment\tDevelopment\tdevelopment\n",
+		},
+		{
+			name:   "ndjson format",
+			format: "ndjson",
+			expectedOutput: "{\"type\":\"ADDED\",\"object\":{\"id\":\"development\",\"name\":\"Development\",\"type\":\"development\"}}\n" +
+				"{\"type\":\"ADDED\",\"object\":{\"id\":\"staging\",\"name\":\"Staging\",\"type\":\"staging\"}}\n" +
+				"{\"type\":\"MODIFIED\",\"object\":{\"id\":\"staging\",\"name\":\"Pre-production\",\"type\":\"staging\"}}\n" +
+				"{\"type\":\"DELETED\",\"object\":{\"id\":\"development\",\"name\":\"Development\",\"type\":\"development\"}}\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			development := humanitec.Environment{ID: "development", Name: "Development", Type: "development"}
+			staging := humanitec.Environment{ID: "staging", Name: "Staging", Type: "staging"}
+			renamed := humanitec.Environment{ID: "staging", Name: "Pre-production", Type: "staging"}
+			test.SetupMockClient(t, &test.MockClient{EnvLists: [][]humanitec.Environment{
+				{development, staging},
+				{development, staging},
+				{development, renamed},
+				{renamed},
+			}})
+
+			// Watching runs until interrupted, so stop it once all lists have been seen
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			got, err := test.ExecuteCommandContext(t, ctx, get, get, nil, map[string]string{
+				constants.AppFlagName:      "test-app",
+				constants.WatchFlagName:    "true",
+				constants.IntervalFlagName: "1ms",
+				constants.OutputFlagName:   tc.format,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedOutput, got)
+		})
+	}
+
+	// Watching a single environment is not supported
+	_, err := test.ExecuteCommand(t, get, get, []string{"staging"}, map[string]string{constants.AppFlagName: "test-app", constants.WatchFlagName: "true"})
+	assert.Error(t, err)
+}
+
+// TestGetEnvCommandConfiguration verifies that the get envs command is properly configured
+// with the correct name, description, and flags.
+func TestGetEnvCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.EnvsCmdUse, get.Name(), "get command should have correct use")
+	assert.Equal(t, constants.EnvsCmdShort, get.Short, "get command should have correct short description")
+
+	assert.True(t, get.Flags().Lookup(constants.AppFlagName) != nil, "get command should have app flag")
+	assert.True(t, get.Flags().Lookup(constants.IDFlagName) != nil, "get command should have id flag")
+	assert.True(t, get.Flags().Lookup(constants.OutputFlagName) != nil, "get command should have output flag")
+	assert.True(t, get.Flags().Lookup(constants.WatchFlagName) != nil, "get command should have watch flag")
+}
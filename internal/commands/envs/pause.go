@@ -0,0 +1,249 @@
+package envs
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for pausing environments
+	pause = newPauseCommand(true)
+
+	// Subcommand for resuming environments
+	resume = newPauseCommand(false)
+)
+
+// target is an environment of an application
+type target struct {
+	app string
+	env string
+}
+
+// String returns the target as "app/env"
+func (t target) String() string {
+	return t.app + "/" + t.env
+}
+
+// newPauseCommand returns the command that pauses or resumes environments
+func newPauseCommand(paused bool) *cobra.Command {
+	verb, title, state := "pause", "Pause", "paused"
+	done, already := output.ResultPaused, output.ResultAlreadyPaused
+	if !paused {
+		verb, title, state = "resume", "Resume", "running"
+		done, already = output.ResultResumed, output.ResultAlreadyRunning
+	}
+
+	cmd := &cobra.Command{
+		Use:   constants.EnvCmdUse + " [id]",
+		Short: constants.EnvCmdShort,
+		Long: fmt.Sprintf(`%s the workloads of an environment.
+With --all-envs-of-type every environment of that type is targeted, in the application
+given with --app or in all applications of the organization. The outcome is reported per
+environment, including environments that were already %s.
+With --all-envs-of-type the targeted environments are listed and the environment type must
+be typed to confirm. Use --yes to skip the confirmation in automation, and --dry-run to print
+the requests instead of sending them.`, title, state),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := cmd.Flags().GetString(constants.AppFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get app flag: %w", err)
+			}
+
+			id, err := envID(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			envType, err := cmd.Flags().GetString(constants.AllEnvsOfTypeFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get all-envs-of-type flag: %w", err)
+			}
+
+			yes, err := cmd.Flags().GetBool(constants.YesFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get yes flag: %w", err)
+			}
+
+			dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Validate input before calling the API
+			switch {
+			case envType != "" && id != "":
+				return clierrors.Validationf("an environment ID cannot be combined with --%s", constants.AllEnvsOfTypeFlagName)
+			case envType != "":
+				if err := validation.ID(validation.EnvType, envType); err != nil {
+					return err
+				}
+			case id == "":
+				return clierrors.Validationf("an environment ID or --%s is required", constants.AllEnvsOfTypeFlagName)
+			default:
+				if err := validation.ID(validation.Environment, id); err != nil {
+					return err
+				}
+			}
+			if app != "" || envType == "" {
+				if err := validation.ID(validation.App, app); err != nil {
+					return err
+				}
+			}
+
+			// Make sure targeting every environment of a type can be confirmed before looking anything up
+			confirmer := prompt.NewConfirmer(cmd.InOrStdin(), cmd.ErrOrStderr(), yes)
+			if envType != "" && !dryRun {
+				if err := confirmer.Check(verb + " all environments of a type"); err != nil {
+					return err
+				}
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			targets := []target{{app: app, env: id}}
+			if envType != "" {
+				if targets, err = envsOfType(client, app, envType); err != nil {
+					return err
+				}
+			}
+
+			// Print the requests instead of sending them on a dry run
+			if dryRun {
+				requests := make([]humanitec.Request, 0, len(targets))
+				for _, t := range targets {
+					requests = append(requests, humanitec.SetEnvPausedRequest(org, t.app, t.env, paused))
+				}
+				return apps.PrintRequests(cmd, requests, outputFormat)
+			}
+
+			// Show the environments and ask for confirmation
+			if envType != "" && len(targets) > 0 {
+				if !yes {
+					out := cmd.ErrOrStderr()
+					fmt.Fprintf(out, "The following %d environments of type %s will be %s:\n", len(targets), envType, done)
+					for _, t := range targets {
+						fmt.Fprintf(out, "  %s\n", t)
+					}
+				}
+				confirmed, err := confirmer.ConfirmByTyping(envType)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					return prompt.ErrAborted
+				}
+			}
+
+			// Only change environments that are not in the requested state yet
+			results := make([]output.Result, len(targets))
+			failed := 0
+			var first error
+			for i, t := range targets {
+				results[i] = output.Result{ID: t.String(), Status: done}
+				err := func() error {
+					runtime, err := client.GetEnvRuntime(t.app, t.env)
+					if err != nil {
+						return err
+					}
+					if runtime.Paused == paused {
+						results[i].Status = already
+						return nil
+					}
+					return client.SetEnvPaused(t.app, t.env, paused)
+				}()
+				if err != nil {
+					results[i].Status = output.ResultFailed
+					results[i].Error = err.Error()
+					failed++
+					if first == nil {
+						first = err
+					}
+				}
+			}
+
+			// Print output
+			formatted, err := output.FormatResults(results, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			if failed > 0 {
+				// The failures are already part of the printed summary
+				cmd.SilenceUsage = true
+				return clierrors.NewSilent(fmt.Errorf("failed to %s %d of %d envs: %w", verb, failed, len(targets), first))
+			}
+			return nil
+		},
+	}
+
+	// Add common flags
+	apps.CommonFlagSet()(cmd)
+
+	// Add command-specific flags
+	cmd.Flags().StringP(constants.AppFlagName, constants.AppFlagShort, "", constants.PauseAppFlagHelp)
+	cmd.Flags().StringP(constants.IDFlagName, constants.IDFlagShort, "", constants.EnvIDFlagHelp)
+	cmd.Flags().String(constants.AllEnvsOfTypeFlagName, "", constants.AllEnvsOfTypeFlagHelp)
+	cmd.Flags().BoolP(constants.YesFlagName, constants.YesFlagShort, false, constants.YesFlagHelp)
+	cmd.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
+
+	return cmd
+}
+
+// envsOfType returns the environments of the given type in app, or in every application if app is empty
+func envsOfType(client humanitec.Client, app, envType string) ([]target, error) {
+	appIDs := []string{app}
+	if app == "" {
+		all, err := client.GetApps()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list apps: %w", err)
+		}
+		appIDs = appIDs[:0]
+		for _, a := range all {
+			appIDs = append(appIDs, a.ID)
+		}
+	}
+
+	var targets []target
+	for _, appID := range appIDs {
+		envs, err := client.GetEnvs(appID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list envs of app %s: %w", appID, err)
+		}
+		for _, env := range envs {
+			if env.Type == envType {
+				targets = append(targets, target{app: appID, env: env.ID})
+			}
+		}
+	}
+	return targets, nil
+}
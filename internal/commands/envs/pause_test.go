@@ -0,0 +1,154 @@
+package envs
+
+import (
+	"io"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPauseEnvCommandExecution verifies that environments are paused and that
+// environments which were already paused are reported as such.
+func TestPauseEnvCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		expectedOutput string
+		expectedPaused map[string]bool
+	}{
+		{
+			name:           "single env",
+			args:           []string{"development"},
+			flags:          map[string]string{constants.AppFlagName: "app-a"},
+			expectedOutput: "ID\tSTATUS\tERROR\n--\t------\t-----\napp-a/development\tpaused\t\n",
+			expectedPaused: map[string]bool{"app-a/development": true, "app-b/dev": true},
+		},
+		{
+			name:           "all envs of type in all apps",
+			flags:          map[string]string{constants.AllEnvsOfTypeFlagName: "development", constants.YesFlagName: "true"},
+			expectedOutput: "ID\tSTATUS\tERROR\n--\t------\t-----\napp-a/development\tpaused\t\napp-b/dev\talready paused\t\n",
+			expectedPaused: map[string]bool{"app-a/development": true, "app-b/dev": true},
+		},
+		{
+			name:           "all envs of type in one app - ndjson format",
+			flags:          map[string]string{constants.AllEnvsOfTypeFlagName: "development", constants.AppFlagName: "app-b", constants.YesFlagName: "true", constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"id\":\"app-b/dev\",\"status\":\"already paused\"}\n",
+			expectedPaused: map[string]bool{"app-b/dev": true},
+		},
+		{
+			name:           "dry run",
+			flags:          map[string]string{constants.AllEnvsOfTypeFlagName: "development", constants.DryRunFlagName: "true", constants.OrgFlagName: "test-org"},
+			expectedOutput: "DRY RUN: PUT /orgs/test-org/apps/app-a/envs/development/runtime/paused\ntrue\nDRY RUN: PUT /orgs/test-org/apps/app-b/envs/dev/runtime/paused\ntrue\n",
+			expectedPaused: map[string]bool{"app-b/dev": true},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &test.MockClient{
+				Apps: []humanitec.App{{ID: "app-a"}, {ID: "app-b"}},
+				EnvsByApp: map[string][]humanitec.Environment{
+					"app-a": {{ID: "development", Type: "development"}, {ID: "production", Type: "production"}},
+					"app-b": {{ID: "dev", Type: "development"}},
+				},
+				Paused: map[string]bool{"app-b/dev": true},
+			}
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommand(t, pause, pause, tt.args, tt.flags)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, got)
+			assert.Equal(t, tt.expectedPaused, mockClient.Paused)
+		})
+	}
+}
+
+// TestResumeEnvCommandExecution verifies that paused environments are resumed and
+// that running environments are left alone.
+func TestResumeEnvCommandExecution(t *testing.T) {
+	mockClient := &test.MockClient{
+		Apps: []humanitec.App{{ID: "app-a"}, {ID: "app-b"}},
+		EnvsByApp: map[string][]humanitec.Environment{
+			"app-a": {{ID: "development", Type: "development"}, {ID: "production", Type: "production"}},
+			"app-b": {{ID: "dev", Type: "development"}},
+		},
+		Paused: map[string]bool{"app-b/dev": true},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	got, err := test.ExecuteCommand(t, resume, resume, []string{}, map[string]string{constants.AllEnvsOfTypeFlagName: "development", constants.YesFlagName: "true"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ID\tSTATUS\tERROR\n--\t------\t-----\napp-a/development\talready running\t\napp-b/dev\tresumed\t\n", got)
+	assert.Equal(t, map[string]bool{"app-b/dev": false}, mockClient.Paused)
+}
+
+// TestPauseEnvConfirmation verifies that pausing every environment of a type must be confirmed
+// by typing the environment type.
+func TestPauseEnvConfirmation(t *testing.T) {
+	flags := map[string]string{constants.AllEnvsOfTypeFlagName: "development"}
+
+	// Input is not a terminal and --yes is not given
+	mockClient := &test.MockClient{
+		Apps: []humanitec.App{{ID: "app-a"}, {ID: "app-b"}},
+		EnvsByApp: map[string][]humanitec.Environment{
+			"app-a": {{ID: "development", Type: "development"}, {ID: "production", Type: "production"}},
+			"app-b": {{ID: "dev", Type: "development"}},
+		},
+		Paused: map[string]bool{"app-b/dev": true},
+	}
+	test.SetupMockClient(t, mockClient)
+	_, err := test.ExecuteCommand(t, pause, pause, []string{}, flags)
+	assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(err))
+	assert.Equal(t, map[string]bool{"app-b/dev": true}, mockClient.Paused)
+
+	isInteractive := prompt.IsInteractive
+	prompt.IsInteractive = func(io.Reader) bool { return true }
+	defer func() { prompt.IsInteractive = isInteractive }()
+
+	_, err = test.ExecuteCommandWithInput(t, pause, pause, []string{}, flags, "yes\n")
+	assert.ErrorIs(t, err, prompt.ErrAborted)
+	assert.Equal(t, map[string]bool{"app-b/dev": true}, mockClient.Paused)
+
+	_, err = test.ExecuteCommandWithInput(t, pause, pause, []string{}, flags, "development\n")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"app-a/development": true, "app-b/dev": true}, mockClient.Paused)
+}
+
+// TestPauseEnvValidation verifies that the target must be given either as an
+// environment ID with --app or with --all-envs-of-type.
+func TestPauseEnvValidation(t *testing.T) {
+	mockClient := &test.MockClient{
+		Apps: []humanitec.App{{ID: "app-a"}, {ID: "app-b"}},
+		EnvsByApp: map[string][]humanitec.Environment{
+			"app-a": {{ID: "development", Type: "development"}, {ID: "production", Type: "production"}},
+			"app-b": {{ID: "dev", Type: "development"}},
+		},
+		Paused: map[string]bool{"app-b/dev": true},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	testCases := []struct {
+		name  string
+		args  []string
+		flags map[string]string
+	}{
+		{name: "no target", flags: map[string]string{constants.AppFlagName: "app-a"}},
+		{name: "missing app", args: []string{"development"}, flags: map[string]string{}},
+		{name: "id and type", args: []string{"development"}, flags: map[string]string{constants.AppFlagName: "app-a", constants.AllEnvsOfTypeFlagName: "development"}},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := test.ExecuteCommand(t, pause, pause, tt.args, tt.flags)
+			assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(err))
+		})
+	}
+}
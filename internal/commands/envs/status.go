@@ -0,0 +1,120 @@
+package envs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command for showing the runtime status of an environment
+	status = &cobra.Command{
+		Use:   constants.StatusCmdUse,
+		Short: constants.StatusCmdShort,
+		Long: `Show whether the workloads of an environment are running in its Kubernetes namespace.
+For each workload the number of ready pods out of its replicas, the container restarts of
+all pods and the overall status are shown; wide output also lists the pods and their phase.
+With --watch the status is refreshed every --interval and changed workloads are printed as
+ADDED, MODIFIED and DELETED events until interrupted. When writing a table to a terminal, the
+whole table is redrawn instead.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := appID(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Get flags
+			env, err := cmd.Flags().GetString(constants.EnvFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get env flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			watchChanges, interval, err := apps.WatchSettings(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Validate input before calling the API
+			if err := validation.ID(validation.Environment, env); err != nil {
+				return err
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			if watchChanges {
+				return watchStatus(cmd, client, app, env, outputFormat, interval)
+			}
+
+			runtime, err := client.GetEnvRuntime(app, env)
+			if err != nil {
+				return fmt.Errorf("failed to get runtime of env %s: %w", env, err)
+			}
+
+			// Print output
+			formatted, err := output.FormatEnvRuntime(runtime, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+// watchStatus prints the workloads of an environment and then the changes to them until the command
+// is interrupted. On a terminal the table is redrawn on every change; otherwise every change is
+// printed as an event.
+func watchStatus(cmd *cobra.Command, client humanitec.Client, app, env string, outputFormat output.Format, interval time.Duration) error {
+	list := func() ([]humanitec.WorkloadRuntime, error) {
+		runtime, err := client.GetEnvRuntime(app, env)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.Workloads(), nil
+	}
+
+	err := output.Watch(cmd.Context(), cmd.OutOrStdout(), interval, list,
+		func(w humanitec.WorkloadRuntime) string { return w.ID }, output.FormatWorkloadRuntimes, outputFormat)
+	if err != nil {
+		return fmt.Errorf("failed to get runtime of env %s: %w", env, err)
+	}
+	return nil
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(status)
+	apps.WatchFlagSet()(status)
+
+	// Add command-specific flags
+	status.Flags().StringP(constants.EnvFlagName, constants.EnvFlagShort, "", constants.EnvFlagHelp)
+	status.MarkFlagRequired(constants.EnvFlagName)
+}
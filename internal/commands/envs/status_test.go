@@ -0,0 +1,145 @@
+package envs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// apiRuntime returns the runtime of a healthy api workload whose second pod is ready if ready is set
+func apiRuntime(ready bool) humanitec.ModuleRuntime {
+	return humanitec.ModuleRuntime{Controllers: map[string]humanitec.ControllerRuntime{
+		"api": {Kind: "Deployment", Replicas: 2, Status: "Success", Pods: []humanitec.PodRuntime{
+			{Name: "api-a", Phase: "Running", ContainerStatuses: []humanitec.ContainerStatus{{Name: "api", Ready: true}}},
+			{Name: "api-b", Phase: "Running", ContainerStatuses: []humanitec.ContainerStatus{{Name: "api", Ready: ready}}},
+		}},
+	}}
+}
+
+// workerRuntime is the runtime of a crashing worker workload
+var workerRuntime = humanitec.ModuleRuntime{Controllers: map[string]humanitec.ControllerRuntime{
+	"worker": {Kind: "Deployment", Replicas: 1, Status: "Failure", Pods: []humanitec.PodRuntime{
+		{Name: "worker-a", Phase: "Running", ContainerStatuses: []humanitec.ContainerStatus{{Name: "worker", RestartCount: 5}}},
+	}},
+}}
+
+// TestStatusCommandExecution verifies that status reports the pods, readiness, restarts and
+// status of every workload of an environment.
+func TestStatusCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		flags          map[string]string
+		expectedOutput string
+		expectedErr    int
+	}{
+		{
+			name:  "table format",
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development"},
+			expectedOutput: "WORKLOAD\tKIND\tREADY\tRESTARTS\tSTATUS\n--------\t----\t-----\t--------\t------\n" +
+				"api\tDeployment\t2/2\t0\tSuccess\n" +
+				"worker\tDeployment\t0/1\t5\tFailure\n",
+		},
+		{
+			name:  "wide format",
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development", constants.OutputFlagName: "wide"},
+			expectedOutput: "WORKLOAD\tKIND\tREADY\tRESTARTS\tSTATUS\tPODS\n--------\t----\t-----\t--------\t------\t----\n" +
+				"api\tDeployment\t2/2\t0\tSuccess\tapi-a (Running),api-b (Running)\n" +
+				"worker\tDeployment\t0/1\t5\tFailure\tworker-a (Running)\n",
+		},
+		{
+			name:  "json format",
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development", constants.OutputFlagName: "json"},
+			expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"EnvironmentRuntime\",\n  \"item\": {\n" +
+				"    \"namespace\": \"test-app-development\",\n    \"paused\": false,\n    \"modules\": {\n      \"api\": {\n" +
+				"        \"controllers\": {\n          \"api\": {\n            \"kind\": \"Deployment\",\n            \"replicas\": 2,\n" +
+				"            \"status\": \"Success\",\n            \"pods\": [\n              {\n                \"podName\": \"api-a\",\n" +
+				"                \"phase\": \"Running\",\n                \"containerStatuses\": [\n                  {\n" +
+				"                    \"name\": \"api\",\n                    \"ready\": true,\n                    \"restartCount\": 0\n" +
+				"                  }\n                ]\n              },\n              {\n                \"podName\": \"api-b\",\n" +
+				"                \"phase\": \"Running\",\n                \"containerStatuses\": [\n                  {\n" +
+				"                    \"name\": \"api\",\n                    \"ready\": true,\n                    \"restartCount\": 0\n" +
+				"                  }\n                ]\n              }\n            ]\n          }\n        }\n      },\n" +
+				"      \"worker\": {\n        \"controllers\": {\n          \"worker\": {\n            \"kind\": \"Deployment\",\n" +
+				"            \"replicas\": 1,\n            \"status\": \"Failure\",\n            \"pods\": [\n              {\n" +
+				"                \"podName\": \"worker-a\",\n                \"phase\": \"Running\",\n" +
+				"                \"containerStatuses\": [\n                  {\n                    \"name\": \"worker\",\n" +
+				"                    \"ready\": false,\n                    \"restartCount\": 5\n                  }\n" +
+				"                ]\n              }\n            ]\n          }\n        }\n      }\n    }\n  }\n}\n",
+		},
+		{
+			name:  "ndjson format",
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development", constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"id\":\"api\",\"kind\":\"Deployment\",\"replicas\":2,\"status\":\"Success\",\"pods\":[{\"podName\":\"api-a\",\"phase\":\"Running\",\"containerStatuses\":[{\"name\":\"api\",\"ready\":true,\"restartCount\":0}]},{\"podName\":\"api-b\",\"phase\":\"Running\",\"containerStatuses\":[{\"name\":\"api\",\"ready\":true,\"restartCount\":0}]}]}\n" +
+				"{\"id\":\"worker\",\"kind\":\"Deployment\",\"replicas\":1,\"status\":\"Failure\",\"pods\":[{\"podName\":\"worker-a\",\"phase\":\"Running\",\"containerStatuses\":[{\"name\":\"worker\",\"ready\":false,\"restartCount\":5}]}]}\n",
+		},
+		{
+			name:        "invalid env id",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "Dev"},
+			expectedErr: clierrors.ExitValidation,
+		},
+		{
+			name:        "invalid interval",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.EnvFlagName: "development", constants.IntervalFlagName: "0s"},
+			expectedErr: clierrors.ExitValidation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			test.SetupMockClient(t, &test.MockClient{ModuleRuntimes: []map[string]humanitec.ModuleRuntime{
+				{"api": apiRuntime(true), "worker": workerRuntime},
+			}})
+
+			got, err := test.ExecuteCommand(t, status, status, nil, tt.flags)
+			assert.Equal(t, tt.expectedErr, clierrors.ExitCode(err), "unexpected error: %v", err)
+			if tt.expectedErr == clierrors.ExitOK {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
+
+// TestStatusWatch verifies that --watch prints the initial workloads as ADDED events followed
+// by the workloads whose runtime changed.
+func TestStatusWatch(t *testing.T) {
+	test.SetupMockClient(t, &test.MockClient{ModuleRuntimes: []map[string]humanitec.ModuleRuntime{
+		{"api": apiRuntime(true), "worker": workerRuntime},
+		{"api": apiRuntime(true), "worker": workerRuntime},
+		{"api": apiRuntime(false)},
+	}})
+
+	// Watching runs until interrupted, so stop it once all runtimes have been seen
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	got, err := test.ExecuteCommandContext(t, ctx, status, status, nil, map[string]string{
+		constants.AppFlagName:      "test-app",
+		constants.EnvFlagName:      "development",
+		constants.WatchFlagName:    "true",
+		constants.IntervalFlagName: "1ms",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "EVENT\tWORKLOAD\tKIND\tREADY\tRESTARTS\tSTATUS\n-----\t--------\t----\t-----\t--------\t------\n"+
+		"ADDED\tapi\tDeployment\t2/2\t0\tSuccess\n"+
+		"ADDED\tworker\tDeployment\t0/1\t5\tFailure\n"+
+		"MODIFIED\tapi\tDeployment\t1/2\t0\tSuccess\n"+
+		"DELETED\tworker\tDeployment\t0/1\t5\tFailure\n", got)
+}
+
+// TestStatusCommandConfiguration verifies that the status command is properly configured
+// with the correct name, description, and flags.
+func TestStatusCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.StatusCmdUse, status.Name(), "status command should have correct use")
+	assert.Equal(t, constants.StatusCmdShort, status.Short, "status command should have correct short description")
+
+	for _, flag := range []string{constants.AppFlagName, constants.EnvFlagName, constants.WatchFlagName, constants.IntervalFlagName, constants.OutputFlagName} {
+		assert.True(t, status.Flags().Lookup(flag) != nil, "status command should have %s flag", flag)
+	}
+}
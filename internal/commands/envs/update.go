@@ -0,0 +1,112 @@
+package envs
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for updating environments
+	update = &cobra.Command{
+		Use:   constants.EnvCmdUse + " [id]",
+		Short: constants.EnvCmdShort,
+		Long: `Update an existing environment of an application.
+Currently supports renaming the environment; its ID and type cannot be changed.
+With --dry-run the request is validated and printed instead of being sent.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := appID(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := envID(cmd, args)
+			if err != nil {
+				return err
+			}
+			if id == "" {
+				return clierrors.Validationf("an environment ID is required")
+			}
+
+			name, err := cmd.Flags().GetString(constants.NameFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get name flag: %w", err)
+			}
+
+			dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Validate input before calling the API
+			if err := validation.ID(validation.Environment, id); err != nil {
+				return err
+			}
+			if err := validation.Name(name); err != nil {
+				return err
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Print the request instead of sending it on a dry run
+			if dryRun {
+				return apps.PrintRequests(cmd, []humanitec.Request{humanitec.UpdateEnvRequest(org, app, id, name)}, outputFormat)
+			}
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			// Update environment
+			env, err := client.UpdateEnv(app, id, name)
+			if err != nil {
+				return fmt.Errorf("failed to update env: %w", err)
+			}
+
+			// Print output
+			formatted, err := output.FormatEnv(env, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(update)
+
+	// Add command-specific flags
+	update.Flags().StringP(constants.IDFlagName, constants.IDFlagShort, "", constants.EnvIDFlagHelp)
+	update.Flags().StringP(constants.NameFlagName, constants.NameFlagShort, "", constants.EnvNameFlagHelp)
+	update.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
+
+	// Mark required flags
+	update.MarkFlagRequired(constants.NameFlagName)
+}
@@ -0,0 +1,94 @@
+package envs
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUpdateEnvCommandExecution verifies that the update env command renames an environment.
+func TestUpdateEnvCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		expectedOutput string
+		expectError    bool
+	}{
+		{
+			name:           "rename by argument",
+			args:           []string{"staging"},
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.NameFlagName: "Pre-production"},
+			expectedOutput: "ID\tNAME\tTYPE\n--\t----\t----\nstaging\tPre-production\tstaging\n",
+		},
+		{
+			name:           "rename by flag - yaml format",
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.IDFlagName: "staging", constants.NameFlagName: "Pre-production", constants.OutputFlagName: "yaml"},
+			expectedOutput: "apiVersion: humctl-wrapper/v1\nkind: Environment\nitem:\n    id: staging\n    name: Pre-production\n    type: staging\n",
+		},
+		{
+			name:           "dry run",
+			args:           []string{"staging"},
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.NameFlagName: "Pre-production", constants.DryRunFlagName: "true", constants.OrgFlagName: "test-org"},
+			expectedOutput: "DRY RUN: PATCH /orgs/test-org/apps/test-app/envs/staging\n{\n  \"name\": \"Pre-production\"\n}\n",
+		},
+		{
+			name:        "missing id",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.NameFlagName: "Pre-production"},
+			expectError: true,
+		},
+		{
+			name:        "conflicting ids",
+			args:        []string{"staging"},
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.IDFlagName: "development", constants.NameFlagName: "Pre-production"},
+			expectError: true,
+		},
+		{
+			name:        "empty name",
+			args:        []string{"staging"},
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.NameFlagName: " "},
+			expectError: true,
+		},
+		{
+			name:        "env not found",
+			args:        []string{"production"},
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.NameFlagName: "Production"},
+			expectError: true,
+		},
+	}
+
+	mockClient := &test.MockClient{
+		Envs: []humanitec.Environment{
+			{ID: "development", Name: "Development", Type: "development", CreatedAt: "2024-01-02T03:04:05Z", CreatedBy: "user-1", LastDeploy: &humanitec.Deployment{ID: "deploy-1", Status: "succeeded"}},
+			{ID: "staging", Name: "Staging", Type: "staging"},
+		},
+		EnvTypes: []humanitec.EnvironmentType{{ID: "development"}, {ID: "staging"}, {ID: "production"}},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(t, update, update, tt.args, tt.flags)
+			if (err != nil) != tt.expectError {
+				t.Errorf("update.Execute() error = %v, wantErr %v", err, tt.expectError)
+				return
+			}
+			if !tt.expectError {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
+
+// TestUpdateEnvCommandConfiguration verifies that the update env command is properly configured
+// with the correct name, description, and flags.
+func TestUpdateEnvCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.EnvCmdUse, update.Name(), "update command should have correct use")
+	assert.Equal(t, constants.EnvCmdShort, update.Short, "update command should have correct short description")
+
+	assert.True(t, update.Flags().Lookup(constants.NameFlagName) != nil, "update command should have name flag")
+	assert.True(t, update.Flags().Lookup(constants.DryRunFlagName) != nil, "update command should have dry-run flag")
+}
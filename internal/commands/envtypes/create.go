@@ -0,0 +1,103 @@
+package envtypes
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for creating environment types
+	create = &cobra.Command{
+		Use:   constants.EnvTypeCmdUse,
+		Short: constants.EnvTypeCmdShort,
+		Long: `Create a new environment type in the organization.
+Environment types group environments, e.g. to apply the same resource definitions
+to every staging environment.
+With --dry-run the request is validated and printed instead of being sent.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Get flags
+			id, err := cmd.Flags().GetString(constants.IDFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get id flag: %w", err)
+			}
+
+			description, err := cmd.Flags().GetString(constants.DescriptionFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get description flag: %w", err)
+			}
+
+			dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Validate input before calling the API
+			if err := validation.ID(validation.EnvType, id); err != nil {
+				return err
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			envType := humanitec.EnvironmentType{ID: id, Description: description}
+
+			// Print the request instead of sending it on a dry run
+			if dryRun {
+				return apps.PrintRequests(cmd, []humanitec.Request{humanitec.CreateEnvTypeRequest(org, envType)}, outputFormat)
+			}
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			// Create environment type
+			created, err := client.CreateEnvType(envType)
+			if err != nil {
+				return fmt.Errorf("failed to create env type: %w", err)
+			}
+
+			// Print output
+			formatted, err := output.FormatEnvType(created, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	// Add common flags
+	apps.CommonFlagSet()(create)
+
+	// Add command-specific flags
+	create.Flags().StringP(constants.IDFlagName, constants.IDFlagShort, "", constants.EnvTypeIDFlagHelp)
+	create.Flags().String(constants.DescriptionFlagName, "", constants.DescriptionFlagHelp)
+	create.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
+
+	// Mark required flags
+	create.MarkFlagRequired(constants.IDFlagName)
+}
@@ -0,0 +1,74 @@
+package envtypes
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCreateEnvTypeCommandExecution verifies the create env-type command's runtime behavior.
+func TestCreateEnvTypeCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		flags          map[string]string
+		expectedOutput string
+		expectError    bool
+	}{
+		{
+			name:           "table format",
+			flags:          map[string]string{constants.IDFlagName: "qa", constants.DescriptionFlagName: "QA environments"},
+			expectedOutput: "ID\tDESCRIPTION\n--\t-----------\nqa\tQA environments\n",
+		},
+		{
+			name:           "yaml format",
+			flags:          map[string]string{constants.IDFlagName: "qa", constants.OutputFlagName: "yaml"},
+			expectedOutput: "apiVersion: humctl-wrapper/v1\nkind: EnvironmentType\nitem:\n    id: qa\n",
+		},
+		{
+			name:           "dry run",
+			flags:          map[string]string{constants.IDFlagName: "qa", constants.DryRunFlagName: "true", constants.OrgFlagName: "test-org"},
+			expectedOutput: "DRY RUN: POST /orgs/test-org/env-types\n{\n  \"id\": \"qa\"\n}\n",
+		},
+		{
+			name:        "invalid id",
+			flags:       map[string]string{constants.IDFlagName: "QA_Env"},
+			expectError: true,
+		},
+		{
+			name:        "missing id flag",
+			flags:       map[string]string{},
+			expectError: true,
+		},
+	}
+
+	mockClient := &test.MockClient{
+		EnvTypes: []humanitec.EnvironmentType{
+			{ID: "development", Description: "Development environments"},
+			{ID: "production"},
+		},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(t, create, create, []string{}, tt.flags)
+			if (err != nil) != tt.expectError {
+				t.Errorf("create.Execute() error = %v, wantErr %v", err, tt.expectError)
+				return
+			}
+			if !tt.expectError {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
+
+// TestCreateEnvTypeCommandConfiguration verifies that the create env-type command is properly configured.
+func TestCreateEnvTypeCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.EnvTypeCmdUse, create.Name())
+	assert.Equal(t, constants.EnvTypeCmdShort, create.Short)
+	assert.True(t, create.Flags().Lookup(constants.DescriptionFlagName) != nil)
+}
@@ -0,0 +1,103 @@
+package envtypes
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var delete = &cobra.Command{
+	Use:   constants.EnvTypeCmdUse + " <id>",
+	Short: constants.EnvTypeCmdShort,
+	Long: `Delete an environment type from the organization.
+The API refuses to delete types that are still used by environments.
+The ID must be typed to confirm; use --yes to skip the confirmation in automation,
+and --dry-run to print the request without sending it.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		if err := validation.ID(validation.EnvType, id); err != nil {
+			return err
+		}
+
+		outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get output format flag: %w", err)
+		}
+
+		// Validate output format
+		outputFormat, err := output.ValidateFormat(outputFormatStr)
+		if err != nil {
+			return fmt.Errorf("invalid output format: %w", err)
+		}
+
+		yes, err := cmd.Flags().GetBool(constants.YesFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get yes flag: %w", err)
+		}
+
+		dryRun, err := cmd.Flags().GetBool(constants.DryRunFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get dry-run flag: %w", err)
+		}
+
+		// Get organization ID from the flag or config
+		org, err := apps.OrgID(cmd)
+		if err != nil {
+			return err
+		}
+		token := config.GetConfig().HumanitecToken
+
+		// Print the request instead of sending it on a dry run
+		if dryRun {
+			return apps.PrintRequests(cmd, []humanitec.Request{humanitec.DeleteEnvTypeRequest(org, id)}, outputFormat)
+		}
+
+		// Ask for confirmation
+		confirmer := prompt.NewConfirmer(cmd.InOrStdin(), cmd.ErrOrStderr(), yes)
+		if err := confirmer.Check("delete environment types"); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "The environment type %s will be deleted.\n", id)
+		confirmed, err := confirmer.ConfirmByTyping(id)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return prompt.ErrAborted
+		}
+
+		// Create Humanitec client
+		client := humanitec.NewClient(token, org)
+
+		// Delete environment type
+		if err := client.DeleteEnvType(id); err != nil {
+			return fmt.Errorf("failed to delete env type: %w", err)
+		}
+
+		// Print output
+		formatted, err := output.FormatSuccess("Environment type successfully deleted", outputFormat)
+		if err != nil {
+			return fmt.Errorf("failed to format output: %w", err)
+		}
+		fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+		return nil
+	},
+}
+
+func init() {
+	// Add common flags
+	apps.CommonFlagSet()(delete)
+
+	// Add command-specific flags
+	delete.Flags().BoolP(constants.YesFlagName, constants.YesFlagShort, false, constants.YesFlagHelp)
+	delete.Flags().Bool(constants.DryRunFlagName, false, constants.DryRunFlagHelp)
+}
@@ -0,0 +1,62 @@
+package envtypes
+
+import (
+	"io"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/prompt"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeleteEnvTypeCommandExecution verifies that the delete env-type command deletes an
+// environment type once confirmed.
+func TestDeleteEnvTypeCommandExecution(t *testing.T) {
+	mockClient := &test.MockClient{
+		EnvTypes: []humanitec.EnvironmentType{
+			{ID: "development", Description: "Development environments"},
+			{ID: "production"},
+		},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	got, err := test.ExecuteCommand(t, delete, delete, []string{"development"}, map[string]string{constants.YesFlagName: "true"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Environment type successfully deleted\n", got)
+
+	_, err = test.ExecuteCommand(t, delete, delete, []string{"staging"}, map[string]string{constants.YesFlagName: "true"})
+	assert.Equal(t, clierrors.ExitNotFound, clierrors.ExitCode(err))
+
+	// Input is not a terminal and --yes is not given
+	_, err = test.ExecuteCommand(t, delete, delete, []string{"development"}, map[string]string{})
+	assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(err))
+
+	got, err = test.ExecuteCommand(t, delete, delete, []string{"development"}, map[string]string{constants.DryRunFlagName: "true", constants.OrgFlagName: "test-org"})
+	assert.NoError(t, err)
+	assert.Equal(t, "DRY RUN: DELETE /orgs/test-org/env-types/development\n", got)
+}
+
+// TestDeleteEnvTypeConfirmation verifies that the environment type ID must be typed to confirm.
+func TestDeleteEnvTypeConfirmation(t *testing.T) {
+	isInteractive := prompt.IsInteractive
+	prompt.IsInteractive = func(io.Reader) bool { return true }
+	defer func() { prompt.IsInteractive = isInteractive }()
+
+	mockClient := &test.MockClient{
+		EnvTypes: []humanitec.EnvironmentType{
+			{ID: "development", Description: "Development environments"},
+			{ID: "production"},
+		},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	_, err := test.ExecuteCommandWithInput(t, delete, delete, []string{"development"}, map[string]string{}, "dev\n")
+	assert.ErrorIs(t, err, prompt.ErrAborted)
+
+	got, err := test.ExecuteCommandWithInput(t, delete, delete, []string{"development"}, map[string]string{}, "development\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "Environment type successfully deleted\n", got)
+}
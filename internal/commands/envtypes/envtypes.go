@@ -0,0 +1,20 @@
+package envtypes
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// GetCommand returns the command for getting environment types
+func GetCommand() *cobra.Command {
+	return get
+}
+
+// CreateCommand returns the command for creating environment types
+func CreateCommand() *cobra.Command {
+	return create
+}
+
+// DeleteCommand returns the command for deleting environment types
+func DeleteCommand() *cobra.Command {
+	return delete
+}
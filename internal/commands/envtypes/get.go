@@ -0,0 +1,89 @@
+package envtypes
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for getting environment types
+	get = &cobra.Command{
+		Use:     constants.EnvTypesCmdUse,
+		Aliases: []string{constants.EnvTypeCmdUse},
+		Short:   constants.EnvTypesCmdShort,
+		Long: `List the environment types of the organization, e.g. development, staging and production.
+With --watch the list is refreshed every --interval and changes are printed as ADDED,
+MODIFIED and DELETED events until interrupted. When writing a table to a terminal, the
+whole table is redrawn instead.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			watchChanges, interval, err := apps.WatchSettings(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			if watchChanges {
+				return watchEnvTypes(cmd, client, outputFormat, interval)
+			}
+
+			envTypes, err := client.GetEnvTypes()
+			if err != nil {
+				return fmt.Errorf("failed to list env types: %w", err)
+			}
+
+			// Print output
+			formatted, err := output.FormatEnvTypes(envTypes, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+// watchEnvTypes prints the environment types and then the changes to them until the command is
+// interrupted.
+func watchEnvTypes(cmd *cobra.Command, client humanitec.Client, outputFormat output.Format, interval time.Duration) error {
+	err := output.Watch(cmd.Context(), cmd.OutOrStdout(), interval, client.GetEnvTypes,
+		func(envType humanitec.EnvironmentType) string { return envType.ID }, output.FormatEnvTypes, outputFormat)
+	if err != nil {
+		return fmt.Errorf("failed to list env types: %w", err)
+	}
+	return nil
+}
+
+func init() {
+	// Add common flags
+	apps.CommonFlagSet()(get)
+	apps.WatchFlagSet()(get)
+}
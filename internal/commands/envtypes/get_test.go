@@ -0,0 +1,91 @@
+package envtypes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGetEnvTypesCommandExecution verifies that the get env-types command lists the
+// environment types of the organization in every output format.
+func TestGetEnvTypesCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		flags          map[string]string
+		expectedOutput string
+	}{
+		{
+			name:           "table format",
+			flags:          map[string]string{},
+			expectedOutput: "ID\tDESCRIPTION\n--\t-----------\ndevelopment\tDevelopment environments\nproduction\t<none>\n",
+		},
+		{
+			name:           "json format",
+			flags:          map[string]string{constants.OutputFlagName: "json"},
+			expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"EnvironmentTypeList\",\n  \"items\": [\n    {\n      \"id\": \"development\",\n      \"description\": \"Development environments\"\n    },\n    {\n      \"id\": \"production\"\n    }\n  ]\n}\n",
+		},
+		{
+			name:           "ndjson format",
+			flags:          map[string]string{constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"id\":\"development\",\"description\":\"Development environments\"}\n{\"id\":\"production\"}\n",
+		},
+	}
+
+	mockClient := &test.MockClient{
+		EnvTypes: []humanitec.EnvironmentType{
+			{ID: "development", Description: "Development environments"},
+			{ID: "production"},
+		},
+	}
+	test.SetupMockClient(t, mockClient)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := test.ExecuteCommand(t, get, get, []string{}, tc.flags)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedOutput, output)
+		})
+	}
+}
+
+// TestGetEnvTypesWatch verifies that --watch prints the initial environment types as ADDED
+// events followed by the changes between listings.
+func TestGetEnvTypesWatch(t *testing.T) {
+	development := humanitec.EnvironmentType{ID: "development", Description: "Development environments"}
+	test.SetupMockClient(t, &test.MockClient{EnvTypeLists: [][]humanitec.EnvironmentType{
+		{development},
+		{development, {ID: "qa"}},
+		{development, {ID: "qa", Description: "QA environments"}},
+		{{ID: "qa", Description: "QA environments"}},
+	}})
+
+	// Watching runs until interrupted, so stop it once all lists have been seen
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	got, err := test.ExecuteCommandContext(t, ctx, get, get, []string{}, map[string]string{
+		constants.WatchFlagName:    "true",
+		constants.IntervalFlagName: "1ms",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "EVENT\tID\tDESCRIPTION\n-----\t--\t-----------\n"+
+		"ADDED\tdevelopment\tDevelopment environments\n"+
+		"ADDED\tqa\t<none>\n"+
+		"MODIFIED\tqa\tQA environments\n"+
+		"DELETED\tdevelopment\tDevelopment environments\n", got)
+}
+
+// TestGetEnvTypesCommandConfiguration verifies that the get env-types command is properly configured.
+func TestGetEnvTypesCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.EnvTypesCmdUse, get.Name())
+	assert.Equal(t, constants.EnvTypesCmdShort, get.Short)
+	assert.True(t, get.Flags().Lookup(constants.OutputFlagName) != nil)
+	assert.True(t, get.Flags().Lookup(constants.WatchFlagName) != nil)
+}
@@ -0,0 +1,120 @@
+// Package importer implements the import command, which recreates an
+// application from an archive written by export app.
+package importer
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/archive"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/snapshot"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   constants.ImportCmdUse,
+	Short: constants.ImportCmdShort,
+	Long: `Import an application from an archive or directory written by "export app".
+The application is recreated with its environments, shared values and pipelines, and the
+exported deployment set of each environment is deployed. Use --org to import into another
+organization and --id to import under a new application ID. Secret shared values are not
+part of the archive and must be set again. If any step fails, the new application is deleted
+again.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Get flags
+		filename, err := cmd.Flags().GetString(constants.FilenameFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get filename flag: %w", err)
+		}
+
+		id, err := cmd.Flags().GetString(constants.IDFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get id flag: %w", err)
+		}
+
+		name, err := cmd.Flags().GetString(constants.NameFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get name flag: %w", err)
+		}
+
+		outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get output format flag: %w", err)
+		}
+
+		// Validate output format
+		outputFormat, err := output.ValidateFormat(outputFormatStr)
+		if err != nil {
+			return fmt.Errorf("invalid output format: %w", err)
+		}
+
+		snap, meta, err := archive.Read(filename)
+		if err != nil {
+			return err
+		}
+
+		// The imported app keeps its exported ID and name unless new ones are given
+		if id == "" {
+			id = snap.App.ID
+		}
+		if name == "" {
+			name = snap.App.Name
+		}
+		if err := validation.ID(validation.App, id); err != nil {
+			return err
+		}
+		if err := validation.Name(name); err != nil {
+			return err
+		}
+
+		// Get organization ID from the flag or config
+		org, err := apps.OrgID(cmd)
+		if err != nil {
+			return err
+		}
+		token := config.GetConfig().HumanitecToken
+
+		// Create Humanitec client
+		client := humanitec.NewClient(token, org)
+
+		app, err := snapshot.Restore(client, snap, id, name, apps.PrintProgress(cmd.ErrOrStderr()))
+		if err != nil {
+			return fmt.Errorf("failed to import app %s: %w", meta.App, err)
+		}
+		for _, key := range meta.ExcludedSecrets {
+			fmt.Fprintf(cmd.ErrOrStderr(), "skipped value %s: secret values are not exported, set it again\n", key)
+		}
+
+		// Print output
+		formatted, err := output.FormatApp(app, outputFormat)
+		if err != nil {
+			return fmt.Errorf("failed to format output: %w", err)
+		}
+		fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+		return nil
+	},
+}
+
+// Command returns the import command
+func Command() *cobra.Command {
+	return importCmd
+}
+
+func init() {
+	// Add common flags
+	apps.CommonFlagSet()(importCmd)
+
+	// Add command-specific flags
+	importCmd.Flags().StringP(constants.FilenameFlagName, constants.FilenameFlagShort, "", constants.ArchiveFlagHelp)
+	importCmd.Flags().StringP(constants.IDFlagName, constants.IDFlagShort, "", constants.IDFlagHelp)
+	importCmd.Flags().StringP(constants.NameFlagName, constants.NameFlagShort, "", constants.NameFlagHelp)
+
+	// Mark required flags
+	importCmd.MarkFlagRequired(constants.FilenameFlagName)
+}
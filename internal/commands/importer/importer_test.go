@@ -0,0 +1,99 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/archive"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/snapshot"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeArchive exports a template app to an archive in a temporary directory and returns its path
+func writeArchive(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "template.tgz")
+	snap := &snapshot.Snapshot{
+		App:          humanitec.App{ID: "template", Name: "Template"},
+		Environments: []humanitec.Environment{{ID: "development", Name: "Development", Type: "development"}},
+		Values:       []humanitec.Value{{Key: "REGION", Value: "eu-west-1"}, {Key: "API_KEY", IsSecret: true}},
+		Sets:         map[string]*humanitec.DeploymentSet{"development": {ID: "set-1"}},
+		Pipelines:    []snapshot.PipelineDefinition{{ID: "deploy", Definition: "name: Deploy\n"}},
+	}
+	if _, err := archive.Write(path, snap, "source-org"); err != nil {
+		t.Fatalf("Failed to write archive: %v", err)
+	}
+	return path
+}
+
+// TestImportCommandExecution verifies that an archive is imported under its exported ID
+// or a new one, and that invalid archives are rejected.
+func TestImportCommandExecution(t *testing.T) {
+	path := writeArchive(t)
+	invalid := filepath.Join(t.TempDir(), "invalid")
+	if err := os.MkdirAll(invalid, 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(invalid, "metadata.yaml"), []byte("apiVersion: humctl-wrapper/archive/v0\nkind: AppArchive\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write metadata: %v", err)
+	}
+
+	testCases := []struct {
+		name           string
+		flags          map[string]string
+		expectedOutput string
+		expectedExit   int
+	}{
+		{
+			name:           "exported id",
+			flags:          map[string]string{constants.FilenameFlagName: path, constants.OrgFlagName: "target-org"},
+			expectedOutput: "NAME\tID\n----\t--\nTemplate\ttemplate\n",
+		},
+		{
+			name:           "new id",
+			flags:          map[string]string{constants.FilenameFlagName: path, constants.IDFlagName: "new-app", constants.NameFlagName: "New App"},
+			expectedOutput: "NAME\tID\n----\t--\nNew App\tnew-app\n",
+		},
+		{
+			name:         "invalid id",
+			flags:        map[string]string{constants.FilenameFlagName: path, constants.IDFlagName: "New_App"},
+			expectedExit: clierrors.ExitValidation,
+		},
+		{
+			name:         "unsupported archive version",
+			flags:        map[string]string{constants.FilenameFlagName: invalid},
+			expectedExit: clierrors.ExitValidation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// The mock returns the app that was requested, like the API does
+			mockClient := &test.MockClient{App: &humanitec.App{ID: "template", Name: "Template"}}
+			if id, ok := tt.flags[constants.IDFlagName]; ok {
+				mockClient.App = &humanitec.App{ID: id, Name: tt.flags[constants.NameFlagName]}
+			}
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommand(t, importCmd, importCmd, []string{}, tt.flags)
+			assert.Equal(t, tt.expectedExit, clierrors.ExitCode(err))
+			if tt.expectedExit == clierrors.ExitOK {
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
+
+// TestImportCommandConfiguration verifies that the import command is properly configured
+// with the correct name, description, and flags.
+func TestImportCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.ImportCmdUse, importCmd.Name(), "import command should have correct use")
+	assert.Equal(t, constants.ImportCmdShort, importCmd.Short, "import command should have correct short description")
+	assert.True(t, importCmd.Flags().Lookup(constants.FilenameFlagName) != nil, "import command should have filename flag")
+	assert.True(t, importCmd.Flags().Lookup(constants.OrgFlagName) != nil, "import command should have org flag")
+}
@@ -1,11 +1,25 @@
 package commands
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
+	"os/signal"
 
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apply"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/deltas"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/deployments"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/diff"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/envs"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/envtypes"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/importer"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/sets"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
 	"github.com/spf13/cobra"
 )
 
@@ -22,16 +36,26 @@ var RootCmd = &cobra.Command{
 	Long:    `A wrapper for the Humanitec CLI that provides additional functionality and a more user-friendly interface.`,
 	Version: fmt.Sprintf("%s (commit: %s, built: %s)", version, commit, date),
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		colorFlag, err := cmd.Flags().GetString(constants.ColorFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get color flag: %w", err)
+		}
+		colorMode, err := output.ParseColorMode(colorFlag)
+		if err != nil {
+			return err
+		}
+		output.ConfigureColor(colorMode, cmd.OutOrStdout())
+
 		if err := config.Initialize("config.yaml"); err != nil {
 			return fmt.Errorf("error loading config: %v", err)
 		}
 
 		cfg := config.GetConfig()
 		if cfg.HumanitecToken == "" {
-			return fmt.Errorf(constants.ErrMissingToken)
+			return clierrors.NewAuth(errors.New(constants.ErrMissingToken))
 		}
 		if cfg.HumanitecOrg == "" {
-			return fmt.Errorf(constants.ErrMissingOrg)
+			return errors.New(constants.ErrMissingOrg)
 		}
 
 		return nil
@@ -68,15 +92,161 @@ func Execute() error {
 	}
 	RootCmd.AddCommand(deleteCmd)
 
+	// Add describe command
+	describeCmd := &cobra.Command{
+		Use:   constants.DescribeCmdUse,
+		Short: constants.DescribeCmdShort,
+	}
+	RootCmd.AddCommand(describeCmd)
+
+	// Add clone command
+	cloneCmd := &cobra.Command{
+		Use:   constants.CloneCmdUse,
+		Short: constants.CloneCmdShort,
+	}
+	RootCmd.AddCommand(cloneCmd)
+
+	// Add export command
+	exportCmd := &cobra.Command{
+		Use:   constants.ExportCmdUse,
+		Short: constants.ExportCmdShort,
+	}
+	RootCmd.AddCommand(exportCmd)
+
+	// Add wait command
+	waitCmd := &cobra.Command{
+		Use:   constants.WaitCmdUse,
+		Short: constants.WaitCmdShort,
+	}
+	RootCmd.AddCommand(waitCmd)
+
+	// Add migrate command
+	migrateCmd := &cobra.Command{
+		Use:   constants.MigrateCmdUse,
+		Short: constants.MigrateCmdShort,
+	}
+	RootCmd.AddCommand(migrateCmd)
+
 	// Add apps as subcommand of each verb
 	getCmd.AddCommand(apps.GetCommand())
 	createCmd.AddCommand(apps.CreateCommand())
 	updateCmd.AddCommand(apps.UpdateCommand())
 	deleteCmd.AddCommand(apps.DeleteCommand())
+	describeCmd.AddCommand(apps.DescribeCommand())
+	cloneCmd.AddCommand(apps.CloneCommand())
+	exportCmd.AddCommand(apps.ExportCommand())
+	waitCmd.AddCommand(apps.WaitCommand())
+	migrateCmd.AddCommand(apps.MigrateCommand())
+
+	// Add pause and resume commands
+	pauseCmd := &cobra.Command{
+		Use:   constants.PauseCmdUse,
+		Short: constants.PauseCmdShort,
+	}
+	RootCmd.AddCommand(pauseCmd)
+	resumeCmd := &cobra.Command{
+		Use:   constants.ResumeCmdUse,
+		Short: constants.ResumeCmdShort,
+	}
+	RootCmd.AddCommand(resumeCmd)
+
+	// Add envs as subcommand of each verb
+	getCmd.AddCommand(envs.GetCommand())
+	createCmd.AddCommand(envs.CreateCommand())
+	updateCmd.AddCommand(envs.UpdateCommand())
+	deleteCmd.AddCommand(envs.DeleteCommand())
+	pauseCmd.AddCommand(envs.PauseCommand())
+	resumeCmd.AddCommand(envs.ResumeCommand())
+	RootCmd.AddCommand(envs.StatusCommand())
+
+	// Add env-types as subcommand of each verb
+	getCmd.AddCommand(envtypes.GetCommand())
+	createCmd.AddCommand(envtypes.CreateCommand())
+	deleteCmd.AddCommand(envtypes.DeleteCommand())
 
-	return RootCmd.Execute()
+	// Add deployments as subcommand of each verb and the top-level deploy command
+	getCmd.AddCommand(deployments.GetCommand())
+	describeCmd.AddCommand(deployments.DescribeCommand())
+	waitCmd.AddCommand(deployments.WaitCommand())
+	RootCmd.AddCommand(deployments.DeployCommand())
+	RootCmd.AddCommand(deployments.RollbackCommand())
+	RootCmd.AddCommand(deployments.PromoteCommand())
+
+	// Add logs command
+	logsCmd := &cobra.Command{
+		Use:   constants.LogsCmdUse,
+		Short: constants.LogsCmdShort,
+	}
+	RootCmd.AddCommand(logsCmd)
+	logsCmd.AddCommand(deployments.LogsCommand())
+
+	// Add patch and archive commands
+	patchCmd := &cobra.Command{
+		Use:   constants.PatchCmdUse,
+		Short: constants.PatchCmdShort,
+	}
+	RootCmd.AddCommand(patchCmd)
+	archiveCmd := &cobra.Command{
+		Use:   constants.ArchiveCmdUse,
+		Short: constants.ArchiveCmdShort,
+	}
+	RootCmd.AddCommand(archiveCmd)
+
+	// Add deltas as subcommand of each verb
+	getCmd.AddCommand(deltas.GetCommand())
+	describeCmd.AddCommand(deltas.DescribeCommand())
+	createCmd.AddCommand(deltas.CreateCommand())
+	patchCmd.AddCommand(deltas.PatchCommand())
+	archiveCmd.AddCommand(deltas.ArchiveCommand())
+	deployments.DeployCommand().AddCommand(deltas.DeployCommand())
+
+	// Add declarative commands
+	RootCmd.AddCommand(apply.Command())
+	RootCmd.AddCommand(diff.Command())
+	RootCmd.AddCommand(importer.Command())
+
+	// Add deployment sets as subcommand of get and diff
+	getCmd.AddCommand(sets.GetCommand())
+	diff.Command().AddCommand(sets.DiffCommand())
+	diff.Command().AddCommand(sets.DiffEnvsCommand())
+
+	// Errors are printed by printError so they can honor the output format
+	RootCmd.SilenceErrors = true
+	RootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return clierrors.NewValidation(err)
+	})
+
+	// Cancel long-running commands such as --watch on Ctrl-C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	cmd, err := RootCmd.ExecuteContextC(ctx)
+	if err != nil {
+		printError(cmd, err)
+	}
+	return err
+}
+
+// printError reports a failed command unless it already reported the failure
+// itself. When a machine-readable output format
+// is selected the error is written to stdout as an error envelope, otherwise
+// it is printed to stderr the same way cobra would.
+func printError(cmd *cobra.Command, err error) {
+	if clierrors.IsSilent(err) {
+		return
+	}
+	if flag := cmd.Flags().Lookup(constants.OutputFlagName); flag != nil {
+		if format, formatErr := output.ValidateFormat(flag.Value.String()); formatErr == nil && output.IsMachineFormat(format) {
+			if formatted, fmtErr := output.FormatError(err, format); fmtErr == nil {
+				fmt.Fprint(cmd.OutOrStdout(), formatted)
+				return
+			}
+		}
+	}
+	cmd.PrintErrln(output.ErrorText(cmd.ErrOrStderr(), cmd.ErrPrefix()+" "+err.Error()))
 }
 
 func init() {
 	RootCmd.PersistentFlags().BoolP(constants.VersionFlagName, constants.VersionFlagShort, false, "Print the version number")
+	RootCmd.PersistentFlags().String(constants.ColorFlagName, constants.DefaultColorMode, constants.ColorFlagHelp)
 } 
\ No newline at end of file
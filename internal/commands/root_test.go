@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/poll"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPrintError verifies that command errors are reported as an error envelope
+// for machine formats and as plain text for table output.
+func TestPrintError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		format         string
+		err            error
+		expectedStdout string
+		expectedStderr string
+	}{
+		{
+			name:           "json format - not found",
+			format:         "json",
+			err:            fmt.Errorf("failed to get app: %w", &humanitec.APIError{StatusCode: 404, Message: "application not found"}),
+			expectedStdout: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"Error\",\n  \"error\": {\n    \"code\": \"NotFound\",\n    \"message\": \"failed to get app: API request failed with status 404: application not found\",\n    \"status\": 404\n  }\n}\n",
+		},
+		{
+			name:           "yaml format - validation",
+			format:         "yaml",
+			err:            clierrors.Validationf("invalid name"),
+			expectedStdout: "apiVersion: humctl-wrapper/v1\nkind: Error\nerror:\n    code: Validation\n    message: invalid name\n",
+		},
+		{
+			name:           "table format",
+			format:         "table",
+			err:            fmt.Errorf("API error"),
+			expectedStderr: "Error: API error\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stdout := new(bytes.Buffer)
+			stderr := new(bytes.Buffer)
+			cmd := &cobra.Command{Use: "test"}
+			cmd.SetOut(stdout)
+			cmd.SetErr(stderr)
+			cmd.Flags().String(constants.OutputFlagName, tc.format, constants.OutputFlagHelp)
+
+			printError(cmd, tc.err)
+
+			assert.Equal(t, tc.expectedStdout, stdout.String())
+			assert.Equal(t, tc.expectedStderr, stderr.String())
+		})
+	}
+}
+
+// TestExitCodes verifies that errors are mapped to distinct exit codes.
+func TestExitCodes(t *testing.T) {
+	assert.Equal(t, clierrors.ExitOK, clierrors.ExitCode(nil))
+	assert.Equal(t, clierrors.ExitError, clierrors.ExitCode(fmt.Errorf("API error")))
+	assert.Equal(t, clierrors.ExitNotFound, clierrors.ExitCode(&humanitec.APIError{StatusCode: 404}))
+	assert.Equal(t, clierrors.ExitConflict, clierrors.ExitCode(&humanitec.APIError{StatusCode: 409}))
+	assert.Equal(t, clierrors.ExitAuth, clierrors.ExitCode(&humanitec.APIError{StatusCode: 401}))
+	assert.Equal(t, clierrors.ExitAuth, clierrors.ExitCode(clierrors.NewAuth(errors.New(constants.ErrMissingToken))))
+	assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(fmt.Errorf("required flag(s) \"id\" not set")))
+	assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(clierrors.Validationf("bad input")))
+	assert.Equal(t, clierrors.ExitTimeout, clierrors.ExitCode(fmt.Errorf("failed waiting: %w", poll.ErrTimeout)))
+	assert.Equal(t, clierrors.ExitNetwork, clierrors.ExitCode(&url.Error{Op: "Get", URL: "https://api.humanitec.io", Err: errors.New("connection refused")}))
+
+	_, err := os.ReadFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Equal(t, clierrors.ExitError, clierrors.ExitCode(fmt.Errorf("failed to read manifests: %w", err)))
+}
+
+// TestPrintErrorColor verifies that errors are printed in red when color is forced, that only
+// success messages are printed in green and that NO_COLOR disables automatic coloring.
+func TestPrintErrorColor(t *testing.T) {
+	defer output.ConfigureColor(output.ColorNever, nil)
+
+	stderr := new(bytes.Buffer)
+	cmd := &cobra.Command{Use: "test"}
+	cmd.SetErr(stderr)
+
+	output.ConfigureColor(output.ColorAlways, stderr)
+	printError(cmd, fmt.Errorf("API error"))
+	assert.Equal(t, "\x1b[31mError: API error\x1b[0m\n", stderr.String())
+
+	message, err := output.FormatMessage("Env staging already runs deployment set set-1 of env development", output.FormatTable)
+	assert.NoError(t, err)
+	assert.Equal(t, "Env staging already runs deployment set set-1 of env development\n", message)
+	message, err = output.FormatSuccess("Application successfully deleted", output.FormatTable)
+	assert.NoError(t, err)
+	assert.Equal(t, "\x1b[32mApplication successfully deleted\x1b[0m\n", message)
+
+	t.Setenv("NO_COLOR", "1")
+	output.ConfigureColor(output.ColorAuto, stderr)
+	assert.False(t, output.ShouldColor(stderr))
+
+	_, err = output.ParseColorMode("sometimes")
+	assert.Error(t, err)
+}
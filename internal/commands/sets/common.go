@@ -0,0 +1,55 @@
+package sets
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+// CommonFlagSet returns a function that adds the common flags and the required --app flag to a command
+func CommonFlagSet() func(*cobra.Command) {
+	return func(cmd *cobra.Command) {
+		apps.CommonFlagSet()(cmd)
+		cmd.Flags().StringP(constants.AppFlagName, constants.AppFlagShort, "", constants.AppIDFlagHelp)
+		cmd.MarkFlagRequired(constants.AppFlagName)
+	}
+}
+
+// appID returns the validated application ID given with --app
+func appID(cmd *cobra.Command) (string, error) {
+	app, err := cmd.Flags().GetString(constants.AppFlagName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get app flag: %w", err)
+	}
+	if err := validation.ID(validation.App, app); err != nil {
+		return "", err
+	}
+	return app, nil
+}
+
+// printDiff prints the differences between two deployment sets. Like diff(1) it returns a
+// silent error, which exits with status 1, if the sets differ.
+func printDiff(cmd *cobra.Command, from, to *humanitec.DeploymentSet, fromName, toName string, format output.Format) error {
+	ops, err := output.SetPatch(from, to)
+	if err != nil {
+		return fmt.Errorf("failed to compute diff: %w", err)
+	}
+
+	formatted, err := output.FormatSetDiff(from, to, fromName, toName, format)
+	if err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+	fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+	if len(ops) > 0 {
+		cmd.SilenceUsage = true
+		return clierrors.NewSilent(clierrors.ErrDifferences)
+	}
+	return nil
+}
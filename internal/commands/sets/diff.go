@@ -0,0 +1,164 @@
+package sets
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for comparing two deployment sets
+	diffSets = &cobra.Command{
+		Use:   constants.SetsCmdUse + " <from> <to>",
+		Short: constants.DiffSetsCmdShort,
+		Long: `Show the differences between two deployment sets of an application.
+The workloads and shared resources that were added (+), changed (~) or removed (-) are listed,
+followed by a unified diff of both sets. With --output json|yaml|ndjson the differences are
+printed as a JSON Patch from the first to the second set. The command exits with status 1
+when the sets differ and 0 when they are equal. Status 1 is also used for unclassified errors;
+unlike differences, those print an error message, or an Error envelope with
+--output json|yaml|ndjson.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := appID(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			from, err := client.GetSet(app, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get deployment set %s: %w", args[0], err)
+			}
+			to, err := client.GetSet(app, args[1])
+			if err != nil {
+				return fmt.Errorf("failed to get deployment set %s: %w", args[1], err)
+			}
+
+			return printDiff(cmd, from, to, "set/"+args[0], "set/"+args[1], outputFormat)
+		},
+	}
+
+	// Subcommand for comparing the deployment sets of two environments
+	diffEnvs = &cobra.Command{
+		Use:   constants.EnvsCmdUse,
+		Short: constants.DiffEnvsCmdShort,
+		Long: `Show the differences between the deployment sets that two environments of an
+application currently run, e.g. what promoting --from development --to production would change.
+An environment that has never been deployed is compared as an empty set. The output and exit
+status are the same as for diff sets.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := appID(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Get flags
+			fromEnv, err := cmd.Flags().GetString(constants.FromFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get from flag: %w", err)
+			}
+
+			toEnv, err := cmd.Flags().GetString(constants.ToFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get to flag: %w", err)
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Validate input before calling the API
+			if err := validation.ID(validation.Environment, fromEnv); err != nil {
+				return err
+			}
+			if err := validation.ID(validation.Environment, toEnv); err != nil {
+				return err
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			from, err := envSet(client, app, fromEnv)
+			if err != nil {
+				return err
+			}
+			to, err := envSet(client, app, toEnv)
+			if err != nil {
+				return err
+			}
+
+			return printDiff(cmd, from, to, "env/"+fromEnv, "env/"+toEnv, outputFormat)
+		},
+	}
+)
+
+// envSet returns the deployment set an environment currently runs, or nil if it has never
+// been deployed
+func envSet(client humanitec.Client, app, env string) (*humanitec.DeploymentSet, error) {
+	e, err := client.GetEnv(app, env)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get env %s: %w", env, err)
+	}
+	if e.LastDeploy == nil {
+		return nil, nil
+	}
+	set, err := client.GetSet(app, e.LastDeploy.SetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment set %s of env %s: %w", e.LastDeploy.SetID, env, err)
+	}
+	return set, nil
+}
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(diffSets)
+	CommonFlagSet()(diffEnvs)
+
+	// Add command-specific flags
+	diffEnvs.Flags().String(constants.FromFlagName, "", constants.DiffFromEnvFlagHelp)
+	diffEnvs.Flags().String(constants.ToFlagName, "", constants.DiffToEnvFlagHelp)
+	diffEnvs.MarkFlagRequired(constants.FromFlagName)
+	diffEnvs.MarkFlagRequired(constants.ToFlagName)
+}
@@ -0,0 +1,181 @@
+package sets
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDiffSetsCommandExecution verifies that diff sets prints the differences between two sets
+// and exits with status 1 if they differ.
+func TestDiffSetsCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		expectedOutput string
+		expectedErr    int
+	}{
+		{
+			name:  "different sets - table format",
+			args:  []string{"set-1", "set-2"},
+			flags: map[string]string{constants.AppFlagName: "test-app"},
+			expectedOutput: "Workloads:\n  ~ api\n  - legacy\n  + worker\nShared resources:\n  + dns\n\n" +
+				"--- set/set-1\n+++ set/set-2\n@@ -1,5 +1,8 @@\n modules:\n     api:\n-        image: api:1.0\n-    legacy:\n-        image: legacy:1\n+        image: api:1.1\n+    worker:\n+        image: worker:1\n+shared:\n+    dns:\n+        type: dns\n",
+			expectedErr: clierrors.ExitDifferences,
+		},
+		{
+			name:  "different sets - ndjson format",
+			args:  []string{"set-1", "set-2"},
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"op\":\"replace\",\"path\":\"/modules/api/image\",\"value\":\"api:1.1\"}\n" +
+				"{\"op\":\"remove\",\"path\":\"/modules/legacy\"}\n" +
+				"{\"op\":\"add\",\"path\":\"/modules/worker\",\"value\":{\"image\":\"worker:1\"}}\n" +
+				"{\"op\":\"add\",\"path\":\"/shared\",\"value\":{\"dns\":{\"type\":\"dns\"}}}\n",
+			expectedErr: clierrors.ExitDifferences,
+		},
+		{
+			name:  "equal sets",
+			args:  []string{"set-1", "set-1"},
+			flags: map[string]string{constants.AppFlagName: "test-app"},
+		},
+		{
+			name:           "equal sets - json format",
+			args:           []string{"set-2", "set-2"},
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.OutputFlagName: "json"},
+			expectedOutput: "{\n  \"apiVersion\": \"humctl-wrapper/v1\",\n  \"kind\": \"JSONPatch\",\n  \"items\": []\n}\n",
+		},
+		{
+			name:        "set not found",
+			args:        []string{"set-1", "set-9"},
+			flags:       map[string]string{constants.AppFlagName: "test-app"},
+			expectedErr: clierrors.ExitNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &test.MockClient{
+				Envs: []humanitec.Environment{
+					{ID: "development", LastDeploy: &humanitec.Deployment{ID: "deploy-1", SetID: "set-2", Status: "succeeded"}},
+					{ID: "staging", LastDeploy: &humanitec.Deployment{ID: "deploy-2", SetID: "set-1", Status: "succeeded"}},
+					{ID: "production"},
+				},
+				Sets: map[string]*humanitec.DeploymentSet{
+					"set-1": {
+						ID:      "set-1",
+						Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.0"}, "legacy": map[string]interface{}{"image": "legacy:1"}},
+						Version: 1,
+					},
+					"set-2": {
+						ID:      "set-2",
+						Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.1"}, "worker": map[string]interface{}{"image": "worker:1"}},
+						Shared:  map[string]interface{}{"dns": map[string]interface{}{"type": "dns"}},
+						Version: 2,
+					},
+				},
+			}
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommand(t, diffSets, diffSets, tt.args, tt.flags)
+			assert.Equal(t, tt.expectedErr, clierrors.ExitCode(err))
+			if tt.expectedErr == clierrors.ExitNotFound {
+				return
+			}
+			assert.Equal(t, tt.expectedOutput, got)
+		})
+	}
+}
+
+// TestDiffEnvsCommandExecution verifies that diff envs compares the sets that two environments
+// currently run.
+func TestDiffEnvsCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		flags          map[string]string
+		expectedOutput string
+		expectedErr    int
+	}{
+		{
+			name:  "different envs - yaml format",
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.FromFlagName: "staging", constants.ToFlagName: "development", constants.OutputFlagName: "yaml"},
+			expectedOutput: "apiVersion: humctl-wrapper/v1\nkind: JSONPatch\nitems:\n" +
+				"    - op: replace\n      path: /modules/api/image\n      value: api:1.1\n" +
+				"    - op: remove\n      path: /modules/legacy\n" +
+				"    - op: add\n      path: /modules/worker\n      value:\n        image: worker:1\n" +
+				"    - op: add\n      path: /shared\n      value:\n        dns:\n            type: dns\n",
+			expectedErr: clierrors.ExitDifferences,
+		},
+		{
+			name:  "never deployed env is empty",
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.FromFlagName: "staging", constants.ToFlagName: "production"},
+			expectedOutput: "Workloads:\n  - api\n  - legacy\n\n" +
+				"--- env/staging\n+++ env/production\n@@ -1,5 +1 @@\n-modules:\n-    api:\n-        image: api:1.0\n-    legacy:\n-        image: legacy:1\n+modules: {}\n",
+			expectedErr: clierrors.ExitDifferences,
+		},
+		{
+			name:  "same env",
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.FromFlagName: "development", constants.ToFlagName: "development"},
+		},
+		{
+			name:        "env not found",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.FromFlagName: "development", constants.ToFlagName: "qa"},
+			expectedErr: clierrors.ExitNotFound,
+		},
+		{
+			name:        "invalid env id",
+			flags:       map[string]string{constants.AppFlagName: "test-app", constants.FromFlagName: "Dev", constants.ToFlagName: "development"},
+			expectedErr: clierrors.ExitValidation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &test.MockClient{
+				Envs: []humanitec.Environment{
+					{ID: "development", LastDeploy: &humanitec.Deployment{ID: "deploy-1", SetID: "set-2", Status: "succeeded"}},
+					{ID: "staging", LastDeploy: &humanitec.Deployment{ID: "deploy-2", SetID: "set-1", Status: "succeeded"}},
+					{ID: "production"},
+				},
+				Sets: map[string]*humanitec.DeploymentSet{
+					"set-1": {
+						ID:      "set-1",
+						Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.0"}, "legacy": map[string]interface{}{"image": "legacy:1"}},
+						Version: 1,
+					},
+					"set-2": {
+						ID:      "set-2",
+						Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.1"}, "worker": map[string]interface{}{"image": "worker:1"}},
+						Shared:  map[string]interface{}{"dns": map[string]interface{}{"type": "dns"}},
+						Version: 2,
+					},
+				},
+			}
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommand(t, diffEnvs, diffEnvs, nil, tt.flags)
+			assert.Equal(t, tt.expectedErr, clierrors.ExitCode(err))
+			if tt.expectedErr == clierrors.ExitNotFound || tt.expectedErr == clierrors.ExitValidation {
+				return
+			}
+			assert.Equal(t, tt.expectedOutput, got)
+		})
+	}
+}
+
+// TestDiffSetsCommandConfiguration verifies that the diff sets and diff envs commands are
+// properly configured with the correct name, description, and flags.
+func TestDiffSetsCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.SetsCmdUse, diffSets.Name(), "diff sets command should have correct use")
+	assert.Equal(t, constants.DiffSetsCmdShort, diffSets.Short, "diff sets command should have correct short description")
+	assert.True(t, diffSets.Flags().Lookup(constants.AppFlagName) != nil, "diff sets command should have app flag")
+
+	assert.Equal(t, constants.EnvsCmdUse, diffEnvs.Name(), "diff envs command should have correct use")
+	assert.Equal(t, constants.DiffEnvsCmdShort, diffEnvs.Short, "diff envs command should have correct short description")
+	assert.True(t, diffEnvs.Flags().Lookup(constants.FromFlagName) != nil, "diff envs command should have from flag")
+	assert.True(t, diffEnvs.Flags().Lookup(constants.ToFlagName) != nil, "diff envs command should have to flag")
+}
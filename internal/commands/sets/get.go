@@ -0,0 +1,89 @@
+package sets
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands/apps"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/config"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Subcommand for getting deployment sets
+	get = &cobra.Command{
+		Use:     constants.SetsCmdUse + " [id]",
+		Aliases: []string{constants.SetCmdUse},
+		Short:   constants.SetsCmdShort,
+		Long: `List the deployment sets of an application, or get a single set by ID.
+Table output shows the workloads and shared resources of each set; use --output yaml or json
+to see their full configuration.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := appID(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormatStr, err := cmd.Flags().GetString(constants.OutputFlagName)
+			if err != nil {
+				return fmt.Errorf("failed to get output format flag: %w", err)
+			}
+
+			// Validate output format
+			outputFormat, err := output.ValidateFormat(outputFormatStr)
+			if err != nil {
+				return fmt.Errorf("invalid output format: %w", err)
+			}
+
+			// Get organization ID from the flag or config
+			org, err := apps.OrgID(cmd)
+			if err != nil {
+				return err
+			}
+			token := config.GetConfig().HumanitecToken
+
+			// Create Humanitec client
+			client := humanitec.NewClient(token, org)
+
+			// If ID is provided, get single set
+			if len(args) == 1 {
+				set, err := client.GetSet(app, args[0])
+				if err != nil {
+					return fmt.Errorf("failed to get deployment set: %w", err)
+				}
+
+				// Print output
+				formatted, err := output.FormatSet(set, outputFormat)
+				if err != nil {
+					return fmt.Errorf("failed to format output: %w", err)
+				}
+				fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+				return nil
+			}
+
+			// Otherwise, list the sets of the application
+			sets, err := client.GetSets(app)
+			if err != nil {
+				return fmt.Errorf("failed to list deployment sets: %w", err)
+			}
+
+			// Print output
+			formatted, err := output.FormatSets(sets, outputFormat)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	// Add common flags
+	CommonFlagSet()(get)
+}
@@ -0,0 +1,105 @@
+package sets
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGetSetCommandExecution verifies that the get sets command lists the deployment sets of an
+// application or gets a single set, in every output format.
+func TestGetSetCommandExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		flags          map[string]string
+		expectedOutput string
+		expectedErr    int
+	}{
+		{
+			name:  "list sets - table format",
+			flags: map[string]string{constants.AppFlagName: "test-app"},
+			expectedOutput: "ID\tWORKLOADS\tSHARED\n--\t---------\t------\n" +
+				"set-1\tapi,legacy\t<none>\n" +
+				"set-2\tapi,worker\tdns\n",
+		},
+		{
+			name:  "get single set - wide format",
+			args:  []string{"set-2"},
+			flags: map[string]string{constants.AppFlagName: "test-app", constants.OutputFlagName: "wide"},
+			expectedOutput: "ID\tWORKLOADS\tSHARED\tVERSION\n--\t---------\t------\t-------\n" +
+				"set-2\tapi,worker\tdns\t2\n",
+		},
+		{
+			name:           "get single set - yaml format",
+			args:           []string{"set-1"},
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.OutputFlagName: "yaml"},
+			expectedOutput: "apiVersion: humctl-wrapper/v1\nkind: DeploymentSet\nitem:\n    id: set-1\n    modules:\n        api:\n            image: api:1.0\n        legacy:\n            image: legacy:1\n    version: 1\n",
+		},
+		{
+			name:           "list sets - ndjson format",
+			flags:          map[string]string{constants.AppFlagName: "test-app", constants.OutputFlagName: "ndjson"},
+			expectedOutput: "{\"id\":\"set-1\",\"modules\":{\"api\":{\"image\":\"api:1.0\"},\"legacy\":{\"image\":\"legacy:1\"}},\"version\":1}\n{\"id\":\"set-2\",\"modules\":{\"api\":{\"image\":\"api:1.1\"},\"worker\":{\"image\":\"worker:1\"}},\"shared\":{\"dns\":{\"type\":\"dns\"}},\"version\":2}\n",
+		},
+		{
+			name:        "set not found",
+			args:        []string{"set-9"},
+			flags:       map[string]string{constants.AppFlagName: "test-app"},
+			expectedErr: clierrors.ExitNotFound,
+		},
+		{
+			name:        "invalid app id",
+			flags:       map[string]string{constants.AppFlagName: "Test_App"},
+			expectedErr: clierrors.ExitValidation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &test.MockClient{
+				Envs: []humanitec.Environment{
+					{ID: "development", LastDeploy: &humanitec.Deployment{ID: "deploy-1", SetID: "set-2", Status: "succeeded"}},
+					{ID: "staging", LastDeploy: &humanitec.Deployment{ID: "deploy-2", SetID: "set-1", Status: "succeeded"}},
+					{ID: "production"},
+				},
+				Sets: map[string]*humanitec.DeploymentSet{
+					"set-1": {
+						ID:      "set-1",
+						Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.0"}, "legacy": map[string]interface{}{"image": "legacy:1"}},
+						Version: 1,
+					},
+					"set-2": {
+						ID:      "set-2",
+						Modules: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.1"}, "worker": map[string]interface{}{"image": "worker:1"}},
+						Shared:  map[string]interface{}{"dns": map[string]interface{}{"type": "dns"}},
+						Version: 2,
+					},
+				},
+			}
+			test.SetupMockClient(t, mockClient)
+
+			got, err := test.ExecuteCommand(t, get, get, tt.args, tt.flags)
+			if tt.expectedErr != 0 {
+				assert.Equal(t, tt.expectedErr, clierrors.ExitCode(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, got)
+		})
+	}
+}
+
+// TestGetSetCommandConfiguration verifies that the get sets command is properly configured
+// with the correct name, description, and flags.
+func TestGetSetCommandConfiguration(t *testing.T) {
+	assert.Equal(t, constants.SetsCmdUse, get.Name(), "get command should have correct use")
+	assert.Equal(t, constants.SetsCmdShort, get.Short, "get command should have correct short description")
+	assert.Contains(t, get.Aliases, constants.SetCmdUse, "get command should have set alias")
+
+	assert.True(t, get.Flags().Lookup(constants.AppFlagName) != nil, "get command should have app flag")
+	assert.True(t, get.Flags().Lookup(constants.OutputFlagName) != nil, "get command should have output flag")
+}
@@ -0,0 +1,20 @@
+package sets
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// GetCommand returns the command for getting deployment sets
+func GetCommand() *cobra.Command {
+	return get
+}
+
+// DiffCommand returns the command for comparing two deployment sets
+func DiffCommand() *cobra.Command {
+	return diffSets
+}
+
+// DiffEnvsCommand returns the command for comparing the deployment sets of two environments
+func DiffEnvsCommand() *cobra.Command {
+	return diffEnvs
+}
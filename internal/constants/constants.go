@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 // Config field names
 const (
 	HumanitecToken = "humanitec_token"
@@ -10,6 +12,9 @@ const (
 const (
 	DefaultOutputFormat = "table"
 	DefaultConfigFile   = "$HOME/config.yaml"
+	DefaultColorMode    = "auto"
+	DefaultWaitTimeout  = 5 * time.Minute
+	DefaultWaitInterval = 2 * time.Second
 )
 
 // Command use strings
@@ -19,8 +24,35 @@ const (
 	CreateCmdUse = "create"
 	UpdateCmdUse = "update"
 	DeleteCmdUse = "delete"
+	DescribeCmdUse = "describe"
+	ApplyCmdUse    = "apply"
+	DiffCmdUse     = "diff"
+	CloneCmdUse    = "clone"
+	ExportCmdUse   = "export"
+	ImportCmdUse   = "import"
+	WaitCmdUse     = "wait"
+	MigrateCmdUse  = "migrate"
+	PauseCmdUse    = "pause"
+	DeployCmdUse   = "deploy"
+	ResumeCmdUse   = "resume"
+	RollbackCmdUse = "rollback"
+	PromoteCmdUse  = "promote"
+	PatchCmdUse    = "patch"
+	ArchiveCmdUse  = "archive"
+	LogsCmdUse     = "logs"
+	StatusCmdUse   = "status"
 	AppsCmdUse   = "apps"
 	AppCmdUse    = "app"
+	EnvsCmdUse   = "envs"
+	EnvCmdUse    = "env"
+	DeploymentsCmdUse = "deployments"
+	DeploymentCmdUse  = "deployment"
+	DeltasCmdUse      = "deltas"
+	DeltaCmdUse       = "delta"
+	SetsCmdUse        = "sets"
+	SetCmdUse         = "set"
+	EnvTypesCmdUse = "env-types"
+	EnvTypeCmdUse  = "env-type"
 )
 
 // Command short descriptions
@@ -30,8 +62,37 @@ const (
 	CreateCmdShort = "Create resources"
 	UpdateCmdShort = "Update resources"
 	DeleteCmdShort = "Delete resources"
+	DescribeCmdShort = "Show details of resources"
+	ApplyCmdShort    = "Apply application manifests"
+	DiffCmdShort     = "Show differences between manifests and live state"
+	CloneCmdShort    = "Copy resources"
+	ExportCmdShort   = "Export resources to an archive"
+	ImportCmdShort   = "Import an application from an archive"
+	WaitCmdShort     = "Wait until resources reach a condition"
+	MigrateCmdShort  = "Move resources to a new ID"
+	PauseCmdShort    = "Pause resources"
+	DeployCmdShort   = "Deploy a delta or deployment set to an environment"
+	ResumeCmdShort   = "Resume paused resources"
+	RollbackCmdShort = "Redeploy a previous deployment of an environment"
+	PromoteCmdShort  = "Deploy the deployment set of one environment to another"
+	PatchCmdShort    = "Change resources with JSON Patch operations"
+	ArchiveCmdShort  = "Archive resources"
+	LogsCmdShort     = "Show the logs of resources"
+	StatusCmdShort   = "Show whether the workloads of an environment are running"
 	AppsCmdShort   = "Manage applications"
 	AppCmdShort    = "Manage a single application"
+	EnvsCmdShort   = "Manage environments"
+	EnvCmdShort    = "Manage a single environment"
+	DeploymentsCmdShort = "Manage deployments"
+	DeploymentCmdShort  = "Manage a single deployment"
+	DeltasCmdShort      = "Manage deltas"
+	DeltaCmdShort       = "Manage a single delta"
+	SetsCmdShort        = "Manage deployment sets"
+	SetCmdShort         = "Manage a single deployment set"
+	DiffSetsCmdShort    = "Show differences between two deployment sets"
+	DiffEnvsCmdShort    = "Show differences between the deployment sets of two environments"
+	EnvTypesCmdShort = "Manage environment types"
+	EnvTypeCmdShort  = "Manage a single environment type"
 )
 
 // Flag names
@@ -41,6 +102,7 @@ const (
 	ConfigFlagShort  = "c"
 	VersionFlagName  = "version"
 	VersionFlagShort = "v"
+	ColorFlagName    = "color"
 
 	// Get apps flags
 	OutputFlagName = "output"
@@ -50,6 +112,64 @@ const (
 	NameFlagName           = "name"
 	SkipEnvCreationFlagName = "skip-env-creation"
 	IDFlagName             = "id"
+	GenerateIDFlagName     = "generate-id"
+
+	// Safety flags
+	YesFlagName    = "yes"
+	DryRunFlagName = "dry-run"
+
+	// Apply flags
+	FilenameFlagName = "filename"
+	PruneFlagName    = "prune"
+
+	// Watch flags
+	WatchFlagName  = "watch"
+	WatchFlagShort = "w"
+
+	// Wait flags
+	ForFlagName      = "for"
+	TimeoutFlagName  = "timeout"
+	IntervalFlagName = "interval"
+
+	// Clone flags
+	FromFlagName               = "from"
+	IncludeDeploymentsFlagName = "include-deployments"
+
+	// Migrate flags
+	ToFlagName             = "to"
+	DeleteSourceFlagName   = "delete-source"
+	DiscardSecretsFlagName = "discard-secrets"
+
+	// Environment flags
+	AppFlagName     = "app"
+	TypeFlagName    = "type"
+	FromEnvFlagName = "from-env"
+
+	// Pause flags
+	AllEnvsOfTypeFlagName = "all-envs-of-type"
+
+	// Deployment flags
+	EnvFlagName     = "env"
+	EnvFlagShort    = "e"
+	DeltaFlagName   = "delta"
+	SetFlagName     = "set"
+	CommentFlagName = "comment"
+	WaitFlagName    = "wait"
+	ErrorsFlagName  = "errors"
+
+	// Rollback flags
+	StepsFlagName = "steps"
+
+	// Promote flags
+	RequireSuccessFlagName = "require-success"
+
+	// Delta flags
+	PatchFlagName    = "patch"
+	PatchFlagShort   = "p"
+	ArchivedFlagName = "archived"
+
+	// Environment type flags
+	DescriptionFlagName = "description"
 
 	// Flag shorthands
 	OutputFlagShort = "o"
@@ -57,6 +177,10 @@ const (
 	NameFlagShort   = "n"
 	SkipEnvCreationFlagShort = "s"
 	IDFlagShort             = "i"
+	FilenameFlagShort       = "f"
+	YesFlagShort            = "y"
+	AppFlagShort            = "a"
+	TypeFlagShort           = "t"
 )
 
 // Help text
@@ -64,15 +188,93 @@ const (
 	// Global help text
 	ConfigFlagHelp   = "config file (default is $HOME/.humctl-wrapper.yaml)"
 	VersionFlagHelp  = "Print the version number"
+	ColorFlagHelp    = "Colorize output (auto|always|never); NO_COLOR disables auto coloring"
 
 	// Get apps help text
-	OutputFlagHelp = "Output format (table|json|yaml)"
+	OutputFlagHelp = "Output format (table|wide|json|yaml|ndjson)"
 	OrgFlagHelp    = "Humanitec organization ID (defaults to %s environment variable)"
 
 	// Create app help text
 	NameFlagHelp           = "Name of the application"
 	SkipEnvCreationFlagHelp = "Skip environment creation"
 	IDFlagHelp             = "Application ID"
+	GenerateIDFlagHelp     = "Generate the application ID from the name if --id is not set"
+
+	// Safety help text
+	YesFlagHelp    = "Skip the confirmation prompt"
+	DryRunFlagHelp = "Validate the input and print the request without sending it"
+
+	// Apply help text
+	FilenameFlagHelp = "Manifest file, directory of manifests, or - for standard input"
+	PruneFlagHelp    = "Delete applications that are not present in the manifests"
+
+	// Watch help text
+	WatchFlagHelp         = "Watch for changes and print them as they happen"
+	WatchIntervalFlagHelp = "Time between checks for changes with --watch"
+
+	// Wait help text
+	ForFlagHelp      = "Condition to wait for"
+	TimeoutFlagHelp  = "Maximum time to wait before failing"
+	IntervalFlagHelp = "Time between checks"
+
+	// Export and import help text
+	ArchiveFlagHelp = "Archive file (.tar.gz or .tgz) or directory"
+
+	// Clone help text
+	FromFlagHelp               = "ID of the application to copy"
+	IncludeDeploymentsFlagHelp = "Deploy the latest deployment set of each source environment to the copy"
+
+	// Migrate help text
+	ToFlagHelp             = "New ID of the application"
+	DeleteSourceFlagHelp   = "Delete the original application after the copy has been verified"
+	DiscardSecretsFlagHelp = "Allow --delete-source although the secret shared values of the original cannot be copied"
+
+	// Environment help text
+	AppFlagHelp     = "ID of the application the environment belongs to"
+	EnvIDFlagHelp   = "Environment ID"
+	EnvNameFlagHelp = "Name of the environment (defaults to the ID)"
+	TypeFlagHelp    = "Environment type, e.g. development"
+	FromEnvFlagHelp = "Start from the current deployment of this environment"
+
+	// Pause help text
+	PauseAppFlagHelp      = "ID of the application; with --all-envs-of-type, all applications if not set"
+	AllEnvsOfTypeFlagHelp = "Target every environment of this type instead of a single environment"
+
+	// Deployment help text
+	EnvFlagHelp        = "ID of the environment"
+	AppIDFlagHelp      = "ID of the application"
+	DeltaFlagHelp      = "ID of the delta to deploy"
+	SetFlagHelp        = "ID of the deployment set to deploy"
+	CommentFlagHelp    = "Comment describing the deployment"
+	WaitFlagHelp       = "Wait for the deployment to finish, printing its status as it changes"
+	ErrorsFlagHelp     = "Only show the errors reported by the deployment, with the time they were reported"
+
+	// Rollback help text
+	ToDeploymentFlagHelp = "ID of the deployment to roll back to"
+	StepsFlagHelp        = "Number of successful deployments to go back in the history"
+
+	// Promote help text
+	PromoteFromFlagHelp    = "ID of the environment whose current deployment set is promoted"
+	PromoteToFlagHelp      = "ID of the environment to deploy the set to"
+	RequireSuccessFlagHelp = "Fail unless the current deployment of the source environment succeeded"
+
+	// Delta help text
+	DeltaFileFlagHelp      = "Delta file in YAML or JSON, or - for standard input"
+	DeltaEnvFlagHelp       = "ID of the environment the delta is intended for"
+	DeltaEnvFilterFlagHelp = "Only list the deltas intended for this environment"
+	DeltaNameFlagHelp      = "Name of the delta"
+	ArchivedFlagHelp       = "List archived instead of active deltas"
+	PatchFlagHelp          = "JSON Patch operations on the modules and shared resources of the delta, in JSON or YAML"
+	PatchFileFlagHelp      = "File with JSON Patch operations, or - for standard input"
+	DeployDeltaEnvFlagHelp = "ID of the environment to deploy to (defaults to the environment of the delta)"
+
+	// Set diff help text
+	DiffFromEnvFlagHelp = "ID of the environment whose deployment set is compared"
+	DiffToEnvFlagHelp   = "ID of the environment whose deployment set it is compared with"
+
+	// Environment type help text
+	EnvTypeIDFlagHelp   = "Environment type ID"
+	DescriptionFlagHelp = "Description of the environment type"
 )
 
 // Error messages
@@ -97,10 +299,17 @@ const (
 	// Create command error messages
 	CreateErrorMissingID   = "Error: required flag(s) \"id\" not set"
 	CreateErrorMissingName = "Error: required flag(s) \"name\" not set"
-	CreateErrorInvalidID   = "Error: invalid id format: must match pattern ^[a-z0-9](?:-?[a-z0-9]+)+$"
-	CreateErrorIDTooLong   = "Error: invalid id format: must be 50 characters or less"
 	CreateErrorDuplicateID = "Error: application with id '%s' already exists"
 	CreateErrorServerError = "Error: failed to create app: %s"
+
+	// Validation error messages
+	ErrEmptyID         = "invalid %s id: must not be empty"
+	ErrInvalidIDFormat = "invalid %s id %q: must match pattern %s"
+	ErrIDTooLong       = "invalid %s id %q: must be %d characters or less"
+	ErrIDSuggestion    = " (did you mean %q?)"
+	ErrEmptyName       = "invalid name: must not be empty"
+	ErrGenerateID      = "cannot generate an id from name %q, please provide one with --id"
+	ErrUnknownValue    = "unknown %s %q: must be one of %s"
 )
 
 // Success messages
@@ -0,0 +1,287 @@
+// Package diffutil computes human-readable unified diffs and JSON Patch
+// (RFC 6902) documents between two versions of a resource.
+package diffutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Patch operations
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+)
+
+// PatchOp is a single JSON Patch operation
+type PatchOp struct {
+	Op    string      `json:"op" yaml:"op"`
+	Path  string      `json:"path" yaml:"path"`
+	Value interface{} `json:"value" yaml:"value"`
+}
+
+// patchOp has the fields of PatchOp without its marshaling methods
+type patchOp PatchOp
+
+// removeOp is a remove operation, which has no value
+type removeOp struct {
+	Op   string `json:"op" yaml:"op"`
+	Path string `json:"path" yaml:"path"`
+}
+
+// MarshalJSON encodes the operation. The value is always encoded for add and replace
+// operations, so that null, false, 0 and "" are kept, and left out for remove operations.
+func (op PatchOp) MarshalJSON() ([]byte, error) {
+	if op.Op == OpRemove {
+		return json.Marshal(removeOp{Op: op.Op, Path: op.Path})
+	}
+	return json.Marshal(patchOp(op))
+}
+
+// MarshalYAML encodes the operation like MarshalJSON
+func (op PatchOp) MarshalYAML() (interface{}, error) {
+	if op.Op == OpRemove {
+		return removeOp{Op: op.Op, Path: op.Path}, nil
+	}
+	return patchOp(op), nil
+}
+
+// Unified returns a unified diff between two texts, or "" if they are equal
+func Unified(from, to, fromName, toName string) (string, error) {
+	if from == to {
+		return "", nil
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(from),
+		B:        splitLines(to),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to compute diff: %w", err)
+	}
+	return diff, nil
+}
+
+// splitLines splits text into lines that keep their line endings.
+// Unlike difflib.SplitLines it does not add an empty line after a trailing newline.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	} else {
+		lines[len(lines)-1] += "\n"
+	}
+	return lines
+}
+
+// JSONPatch returns the operations that transform from into to. Both values
+// are compared through their JSON representation. Arrays of different length
+// are replaced as a whole.
+func JSONPatch(from, to interface{}) ([]PatchOp, error) {
+	fromDoc, err := normalize(from)
+	if err != nil {
+		return nil, err
+	}
+	toDoc, err := normalize(to)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := []PatchOp{}
+	compare("", fromDoc, toDoc, &ops)
+	return ops, nil
+}
+
+// normalize converts v into generic JSON values (maps, slices and scalars)
+func normalize(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal to JSON: %w", err)
+	}
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+	return doc, nil
+}
+
+// compare appends the operations that transform from into to at path
+func compare(path string, from, to interface{}, ops *[]PatchOp) {
+	if reflect.DeepEqual(from, to) {
+		return
+	}
+
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if fromIsMap && toIsMap {
+		for _, key := range sortedKeys(fromMap, toMap) {
+			childPath := path + "/" + escape(key)
+			fromValue, inFrom := fromMap[key]
+			toValue, inTo := toMap[key]
+			switch {
+			case !inTo:
+				*ops = append(*ops, PatchOp{Op: OpRemove, Path: childPath})
+			case !inFrom:
+				*ops = append(*ops, PatchOp{Op: OpAdd, Path: childPath, Value: toValue})
+			default:
+				compare(childPath, fromValue, toValue, ops)
+			}
+		}
+		return
+	}
+
+	fromSlice, fromIsSlice := from.([]interface{})
+	toSlice, toIsSlice := to.([]interface{})
+	if fromIsSlice && toIsSlice && len(fromSlice) == len(toSlice) {
+		for i := range fromSlice {
+			compare(fmt.Sprintf("%s/%d", path, i), fromSlice[i], toSlice[i], ops)
+		}
+		return
+	}
+
+	*ops = append(*ops, PatchOp{Op: OpReplace, Path: path, Value: to})
+}
+
+// sortedKeys returns the union of the keys of both maps in lexical order
+func sortedKeys(a, b map[string]interface{}) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// escape encodes a key as a JSON Pointer reference token
+func escape(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+// Apply applies JSON Patch operations to doc and returns the patched document.
+// doc is compared through its JSON representation like in JSONPatch; the add,
+// remove and replace operations are supported.
+func Apply(doc interface{}, ops []PatchOp) (interface{}, error) {
+	root, err := normalize(doc)
+	if err != nil {
+		return nil, err
+	}
+	for i, op := range ops {
+		value, err := normalize(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		if root, err = applyOp(root, op.Op, pointer(op.Path), value); err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i, op.Op, op.Path, err)
+		}
+	}
+	return root, nil
+}
+
+// pointer splits a JSON Pointer into its unescaped reference tokens
+func pointer(path string) []string {
+	if path == "" {
+		return nil
+	}
+	tokens := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens
+}
+
+// applyOp applies a single operation at the location given by tokens below node
+// and returns the changed node
+func applyOp(node interface{}, op string, tokens []string, value interface{}) (interface{}, error) {
+	if len(tokens) == 0 {
+		switch op {
+		case OpAdd, OpReplace:
+			return value, nil
+		default:
+			return nil, fmt.Errorf("cannot %s the whole document", op)
+		}
+	}
+
+	token, rest := tokens[0], tokens[1:]
+	switch n := node.(type) {
+	case map[string]interface{}:
+		child, exists := n[token]
+		if len(rest) > 0 {
+			if !exists {
+				return nil, fmt.Errorf("path %q does not exist", token)
+			}
+			changed, err := applyOp(child, op, rest, value)
+			if err != nil {
+				return nil, err
+			}
+			n[token] = changed
+			return n, nil
+		}
+		switch op {
+		case OpAdd:
+			n[token] = value
+		case OpReplace:
+			if !exists {
+				return nil, fmt.Errorf("path %q does not exist", token)
+			}
+			n[token] = value
+		case OpRemove:
+			if !exists {
+				return nil, fmt.Errorf("path %q does not exist", token)
+			}
+			delete(n, token)
+		default:
+			return nil, fmt.Errorf("unsupported operation %q", op)
+		}
+		return n, nil
+
+	case []interface{}:
+		if len(rest) == 0 && op == OpAdd && token == "-" {
+			return append(n, value), nil
+		}
+		index, err := strconv.Atoi(token)
+		if err != nil || index < 0 || index > len(n) || (index == len(n) && (len(rest) > 0 || op != OpAdd)) {
+			return nil, fmt.Errorf("invalid array index %q", token)
+		}
+		if len(rest) > 0 {
+			changed, err := applyOp(n[index], op, rest, value)
+			if err != nil {
+				return nil, err
+			}
+			n[index] = changed
+			return n, nil
+		}
+		switch op {
+		case OpAdd:
+			n = append(n, nil)
+			copy(n[index+1:], n[index:])
+			n[index] = value
+		case OpReplace:
+			n[index] = value
+		case OpRemove:
+			n = append(n[:index], n[index+1:]...)
+		default:
+			return nil, fmt.Errorf("unsupported operation %q", op)
+		}
+		return n, nil
+
+	default:
+		return nil, fmt.Errorf("path %q does not exist", token)
+	}
+}
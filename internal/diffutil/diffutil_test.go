@@ -0,0 +1,290 @@
+package diffutil
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+// TestPatchOpMarshal verifies that zero values of add and replace operations are encoded
+// and that remove operations have no value
+func TestPatchOpMarshal(t *testing.T) {
+	testCases := []struct {
+		name         string
+		op           PatchOp
+		expectedJSON string
+		expectedYAML string
+	}{
+		{
+			name:         "add null",
+			op:           PatchOp{Op: OpAdd, Path: "/modules/api/profile", Value: nil},
+			expectedJSON: `{"op":"add","path":"/modules/api/profile","value":null}`,
+			expectedYAML: "op: add\npath: /modules/api/profile\nvalue: null\n",
+		},
+		{
+			name:         "replace false",
+			op:           PatchOp{Op: OpReplace, Path: "/modules/api/enabled", Value: false},
+			expectedJSON: `{"op":"replace","path":"/modules/api/enabled","value":false}`,
+			expectedYAML: "op: replace\npath: /modules/api/enabled\nvalue: false\n",
+		},
+		{
+			name:         "replace zero",
+			op:           PatchOp{Op: OpReplace, Path: "/modules/api/replicas", Value: 0},
+			expectedJSON: `{"op":"replace","path":"/modules/api/replicas","value":0}`,
+			expectedYAML: "op: replace\npath: /modules/api/replicas\nvalue: 0\n",
+		},
+		{
+			name:         "add empty string",
+			op:           PatchOp{Op: OpAdd, Path: "/modules/api/image", Value: ""},
+			expectedJSON: `{"op":"add","path":"/modules/api/image","value":""}`,
+			expectedYAML: "op: add\npath: /modules/api/image\nvalue: \"\"\n",
+		},
+		{
+			name:         "remove",
+			op:           PatchOp{Op: OpRemove, Path: "/modules/api"},
+			expectedJSON: `{"op":"remove","path":"/modules/api"}`,
+			expectedYAML: "op: remove\npath: /modules/api\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.op)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedJSON, string(data))
+
+			data, err = yaml.Marshal(tc.op)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedYAML, string(data))
+		})
+	}
+}
+
+// TestJSONPatch verifies that the patch between two documents adds, removes and replaces
+// single keys with escaped paths, and replaces arrays of different length as a whole
+func TestJSONPatch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     interface{}
+		to       interface{}
+		expected []PatchOp
+	}{
+		{
+			name:     "equal",
+			from:     map[string]interface{}{"api": map[string]interface{}{"image": "api:1.0"}},
+			to:       map[string]interface{}{"api": map[string]interface{}{"image": "api:1.0"}},
+			expected: []PatchOp{},
+		},
+		{
+			name: "added, removed and replaced keys",
+			from: map[string]interface{}{"api": map[string]interface{}{"image": "api:1.0"}, "legacy": true},
+			to:   map[string]interface{}{"api": map[string]interface{}{"image": "api:1.1"}, "worker": false},
+			expected: []PatchOp{
+				{Op: OpReplace, Path: "/api/image", Value: "api:1.1"},
+				{Op: OpRemove, Path: "/legacy"},
+				{Op: OpAdd, Path: "/worker", Value: false},
+			},
+		},
+		{
+			name:     "escaped key",
+			from:     map[string]interface{}{},
+			to:       map[string]interface{}{"a/b~c": "x"},
+			expected: []PatchOp{{Op: OpAdd, Path: "/a~1b~0c", Value: "x"}},
+		},
+		{
+			name:     "array of the same length",
+			from:     map[string]interface{}{"ports": []interface{}{80, 443}},
+			to:       map[string]interface{}{"ports": []interface{}{80, 8443}},
+			expected: []PatchOp{{Op: OpReplace, Path: "/ports/1", Value: float64(8443)}},
+		},
+		{
+			name:     "array of another length",
+			from:     map[string]interface{}{"ports": []interface{}{80}},
+			to:       map[string]interface{}{"ports": []interface{}{80, 443}},
+			expected: []PatchOp{{Op: OpReplace, Path: "/ports", Value: []interface{}{float64(80), float64(443)}}},
+		},
+		{
+			name:     "structs are compared as JSON",
+			from:     struct{ Name string }{Name: "a"},
+			to:       map[string]interface{}{"Name": "b"},
+			expected: []PatchOp{{Op: OpReplace, Path: "/Name", Value: "b"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ops, err := JSONPatch(tc.from, tc.to)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, ops)
+		})
+	}
+}
+
+// TestUnified verifies that equal texts have no diff and that changed lines are shown with
+// the given file names, with or without a trailing newline
+func TestUnified(t *testing.T) {
+	diff, err := Unified("a\nb\n", "a\nb\n", "live", "manifest")
+	assert.NoError(t, err)
+	assert.Equal(t, "", diff)
+
+	diff, err = Unified("a\nb\n", "a\nc", "live", "manifest")
+	assert.NoError(t, err)
+	assert.Equal(t, "--- live\n+++ manifest\n@@ -1,2 +1,2 @@\n a\n-b\n+c\n", diff)
+}
+
+// TestApply verifies that patch operations are applied as specified by RFC 6902, including
+// array indexes, the "-" index and escaped reference tokens
+func TestApply(t *testing.T) {
+	doc := map[string]interface{}{
+		"modules": map[string]interface{}{
+			"api":     map[string]interface{}{"image": "api:1.0"},
+			"a/b~c":   map[string]interface{}{"image": "escaped:1"},
+			"workers": []interface{}{"worker-1", "worker-2"},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		ops         []PatchOp
+		expected    interface{}
+		expectedErr bool
+	}{
+		{
+			name: "add key",
+			ops:  []PatchOp{{Op: OpAdd, Path: "/modules/api/replicas", Value: 2}},
+			expected: map[string]interface{}{"modules": map[string]interface{}{
+				"api":     map[string]interface{}{"image": "api:1.0", "replicas": float64(2)},
+				"a/b~c":   map[string]interface{}{"image": "escaped:1"},
+				"workers": []interface{}{"worker-1", "worker-2"},
+			}},
+		},
+		{
+			name: "add null",
+			ops:  []PatchOp{{Op: OpAdd, Path: "/modules/api/profile", Value: nil}},
+			expected: map[string]interface{}{"modules": map[string]interface{}{
+				"api":     map[string]interface{}{"image": "api:1.0", "profile": nil},
+				"a/b~c":   map[string]interface{}{"image": "escaped:1"},
+				"workers": []interface{}{"worker-1", "worker-2"},
+			}},
+		},
+		{
+			name: "add at index",
+			ops:  []PatchOp{{Op: OpAdd, Path: "/modules/workers/1", Value: "worker-3"}},
+			expected: map[string]interface{}{"modules": map[string]interface{}{
+				"api":     map[string]interface{}{"image": "api:1.0"},
+				"a/b~c":   map[string]interface{}{"image": "escaped:1"},
+				"workers": []interface{}{"worker-1", "worker-3", "worker-2"},
+			}},
+		},
+		{
+			name: "add at index equal to length",
+			ops:  []PatchOp{{Op: OpAdd, Path: "/modules/workers/2", Value: "worker-3"}},
+			expected: map[string]interface{}{"modules": map[string]interface{}{
+				"api":     map[string]interface{}{"image": "api:1.0"},
+				"a/b~c":   map[string]interface{}{"image": "escaped:1"},
+				"workers": []interface{}{"worker-1", "worker-2", "worker-3"},
+			}},
+		},
+		{
+			name: "add at end",
+			ops:  []PatchOp{{Op: OpAdd, Path: "/modules/workers/-", Value: "worker-3"}},
+			expected: map[string]interface{}{"modules": map[string]interface{}{
+				"api":     map[string]interface{}{"image": "api:1.0"},
+				"a/b~c":   map[string]interface{}{"image": "escaped:1"},
+				"workers": []interface{}{"worker-1", "worker-2", "worker-3"},
+			}},
+		},
+		{
+			name: "replace escaped key",
+			ops:  []PatchOp{{Op: OpReplace, Path: "/modules/a~1b~0c/image", Value: "escaped:2"}},
+			expected: map[string]interface{}{"modules": map[string]interface{}{
+				"api":     map[string]interface{}{"image": "api:1.0"},
+				"a/b~c":   map[string]interface{}{"image": "escaped:2"},
+				"workers": []interface{}{"worker-1", "worker-2"},
+			}},
+		},
+		{
+			name: "remove key and index",
+			ops: []PatchOp{
+				{Op: OpRemove, Path: "/modules/api"},
+				{Op: OpRemove, Path: "/modules/workers/0"},
+			},
+			expected: map[string]interface{}{"modules": map[string]interface{}{
+				"a/b~c":   map[string]interface{}{"image": "escaped:1"},
+				"workers": []interface{}{"worker-2"},
+			}},
+		},
+		{
+			name:     "replace whole document",
+			ops:      []PatchOp{{Op: OpReplace, Path: "", Value: map[string]interface{}{}}},
+			expected: map[string]interface{}{},
+		},
+		{
+			name:        "remove missing key",
+			ops:         []PatchOp{{Op: OpRemove, Path: "/modules/missing"}},
+			expectedErr: true,
+		},
+		{
+			name:        "replace missing key",
+			ops:         []PatchOp{{Op: OpReplace, Path: "/modules/missing", Value: "x"}},
+			expectedErr: true,
+		},
+		{
+			name:        "add below missing key",
+			ops:         []PatchOp{{Op: OpAdd, Path: "/modules/missing/image", Value: "x"}},
+			expectedErr: true,
+		},
+		{
+			name:        "add beyond end",
+			ops:         []PatchOp{{Op: OpAdd, Path: "/modules/workers/3", Value: "worker-3"}},
+			expectedErr: true,
+		},
+		{
+			name:        "replace at index equal to length",
+			ops:         []PatchOp{{Op: OpReplace, Path: "/modules/workers/2", Value: "worker-3"}},
+			expectedErr: true,
+		},
+		{
+			name:        "remove end",
+			ops:         []PatchOp{{Op: OpRemove, Path: "/modules/workers/-"}},
+			expectedErr: true,
+		},
+		{
+			name:        "remove whole document",
+			ops:         []PatchOp{{Op: OpRemove, Path: ""}},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Apply(doc, tc.ops)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+// TestJSONPatchApply verifies that applying the patch between two documents to the first
+// one yields the second one
+func TestJSONPatchApply(t *testing.T) {
+	from := map[string]interface{}{"api": map[string]interface{}{"image": "api:1.0", "replicas": 2}, "legacy": true}
+	to := map[string]interface{}{"api": map[string]interface{}{"image": "api:1.1", "replicas": 0}, "worker": nil}
+
+	ops, err := JSONPatch(from, to)
+	if !assert.NoError(t, err) {
+		return
+	}
+	got, err := Apply(from, ops)
+	assert.NoError(t, err)
+
+	expected, err := normalize(to)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, got)
+}
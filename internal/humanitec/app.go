@@ -0,0 +1,87 @@
+package humanitec
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// App represents a Humanitec application
+type App struct {
+	ID   string `json:"id" yaml:"id"`
+	Name string `json:"name" yaml:"name"`
+	// CreatedAt is the RFC 3339 timestamp at which the application was created
+	CreatedAt string `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	// CreatedBy is the ID of the user who created the application
+	CreatedBy string `json:"created_by,omitempty" yaml:"created_by,omitempty"`
+	// Envs lists the environments of the application
+	Envs []EnvSummary `json:"envs,omitempty" yaml:"envs,omitempty"`
+	// Extra holds fields returned by the API that are not modeled above,
+	// so that they survive a JSON or YAML round trip
+	Extra map[string]interface{} `json:"-" yaml:",inline"`
+}
+
+// EnvSummary is the short form of an environment embedded in an App
+type EnvSummary struct {
+	ID   string `json:"id" yaml:"id"`
+	Name string `json:"name" yaml:"name"`
+	Type string `json:"type" yaml:"type"`
+}
+
+// appFields is an alias of App without its JSON methods, used to avoid recursion
+type appFields App
+
+// UnmarshalJSON decodes an App, collecting unknown fields into Extra
+func (a *App) UnmarshalJSON(data []byte) error {
+	var fields appFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for _, key := range []string{"id", "name", "created_at", "created_by", "envs"} {
+		delete(raw, key)
+	}
+	if len(raw) > 0 {
+		fields.Extra = raw
+	}
+
+	*a = App(fields)
+	return nil
+}
+
+// MarshalJSON encodes an App, appending the fields in Extra after the known fields
+func (a App) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(appFields(a))
+	if err != nil || len(a.Extra) == 0 {
+		return data, err
+	}
+
+	keys := make([]string, 0, len(a.Extra))
+	for key := range a.Extra {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1])
+	for _, key := range keys {
+		name, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(a.Extra[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteByte(',')
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
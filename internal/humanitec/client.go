@@ -4,20 +4,18 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 )
 
-// App represents a Humanitec application
-type App struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-}
-
 // Client interface defines the methods that a Humanitec client must implement
 type Client interface {
 	// GetApps retrieves all applications in the organization
 	GetApps() ([]App, error)
+	// WalkApps calls fn for every application in the organization as pages
+	// are received, without loading the whole list into memory
+	WalkApps(fn func(App) error) error
 	// GetApp retrieves a specific application by its ID
 	GetApp(name string) (*App, error)
 	// CreateApp creates a new application with the given ID and name
@@ -27,6 +25,65 @@ type Client interface {
 	DeleteApp(name string) error
 	// UpdateApp updates an application's name by its ID
 	UpdateApp(oldName string, newName string) (*App, error)
+
+	// GetEnvs retrieves the environments of an application
+	GetEnvs(appID string) ([]Environment, error)
+	// GetEnv retrieves a specific environment of an application
+	GetEnv(appID, envID string) (*Environment, error)
+	// CreateEnv creates an environment in an application. If FromDeployID is set,
+	// the environment starts from the deployment set of that deployment
+	CreateEnv(appID string, env Environment) (*Environment, error)
+	// UpdateEnv updates an environment's name
+	UpdateEnv(appID, envID, name string) (*Environment, error)
+	// DeleteEnv deletes an environment of an application
+	DeleteEnv(appID, envID string) error
+	// GetEnvRuntime retrieves the runtime state of an environment
+	GetEnvRuntime(appID, envID string) (*EnvRuntime, error)
+	// SetEnvPaused pauses or resumes the workloads of an environment
+	SetEnvPaused(appID, envID string, paused bool) error
+	// GetEnvTypes retrieves the environment types of the organization
+	GetEnvTypes() ([]EnvironmentType, error)
+	// CreateEnvType creates an environment type in the organization
+	CreateEnvType(envType EnvironmentType) (*EnvironmentType, error)
+	// DeleteEnvType deletes an environment type by its ID
+	DeleteEnvType(id string) error
+
+	// GetValues retrieves the shared values of an application
+	GetValues(appID string) ([]Value, error)
+	// CreateValue creates a shared value in an application
+	CreateValue(appID string, value Value) (*Value, error)
+	// GetPipelines retrieves the pipelines attached to an application
+	GetPipelines(appID string) ([]Pipeline, error)
+	// GetPipelineDefinition retrieves the YAML definition of a pipeline
+	GetPipelineDefinition(appID, pipelineID string) (string, error)
+	// CreatePipeline creates a pipeline from its YAML definition
+	CreatePipeline(appID, definition string) (*Pipeline, error)
+
+	// GetSets lists the deployment sets of an application
+	GetSets(appID string) ([]DeploymentSet, error)
+	// GetSet retrieves a deployment set of an application
+	GetSet(appID, setID string) (*DeploymentSet, error)
+	// GetDeltas retrieves the active or archived deltas of an application,
+	// optionally only those intended for an environment
+	GetDeltas(appID, envID string, archived bool) ([]Delta, error)
+	// GetDelta retrieves a specific delta of an application
+	GetDelta(appID, deltaID string) (*Delta, error)
+	// CreateDelta creates a delta in an application
+	CreateDelta(appID string, delta Delta) (*Delta, error)
+	// UpdateDelta replaces the content of a delta
+	UpdateDelta(appID, deltaID string, delta Delta) (*Delta, error)
+	// ArchiveDelta archives a delta
+	ArchiveDelta(appID, deltaID string) error
+	// GetDeployments retrieves the deployments of an environment, most recent first
+	GetDeployments(appID, envID string) ([]Deployment, error)
+	// GetDeployment retrieves a specific deployment of an environment
+	GetDeployment(appID, envID, deployID string) (*Deployment, error)
+	// GetDeploymentErrors retrieves the errors of a failed deployment
+	GetDeploymentErrors(appID, envID, deployID string) ([]DeploymentError, error)
+	// GetDeploymentLogs retrieves the log lines of the steps of a deployment
+	GetDeploymentLogs(appID, envID, deployID string) ([]DeploymentLog, error)
+	// CreateDeployment deploys a delta or deployment set to an environment
+	CreateDeployment(appID, envID string, req DeploymentRequest) (*Deployment, error)
 }
 
 // ClientFactory creates Humanitec clients
@@ -83,19 +140,49 @@ func (c *humanitecClient) Validate() error {
 	return nil
 }
 
-// GetApps returns a list of applications
-func (c *humanitecClient) GetApps() ([]App, error) {
+// rawBody is a request body that is sent as is instead of being encoded as JSON
+type rawBody struct {
+	contentType string
+	data        []byte
+}
+
+// do sends a request to the Humanitec API and decodes the JSON response into out.
+// A nil body sends no payload and a nil out discards the response body.
+// A rawBody is sent unencoded and an out of type *[]byte receives the raw response.
+// Any status code not listed in expected is returned as an *APIError.
+func (c *humanitecClient) do(method, path string, body interface{}, out interface{}, expected ...int) error {
+	_, err := c.request(method, c.baseURL+path, body, out, expected...)
+	return err
+}
+
+// request sends a request to an absolute API URL and returns the response headers
+func (c *humanitecClient) request(method, rawURL string, body interface{}, out interface{}, expected ...int) (http.Header, error) {
 	if err := c.Validate(); err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/orgs/%s/apps", c.baseURL, c.org), nil)
+	var reader io.Reader
+	contentType := "application/json"
+	switch b := body.(type) {
+	case nil:
+	case rawBody:
+		reader = bytes.NewReader(b.data)
+		contentType = b.contentType
+	default:
+		jsonData, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reader = bytes.NewBuffer(jsonData)
+	}
+
+	req, err := http.NewRequest(method, rawURL, reader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 
 	resp, err := c.client.Do(req)
 	if err != nil {
@@ -103,166 +190,100 @@ func (c *humanitecClient) GetApps() ([]App, error) {
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+	if !containsStatus(expected, resp.StatusCode) {
+		return nil, newAPIError(resp)
 	}
 
-	var apps []App
-	if err := json.NewDecoder(resp.Body).Decode(&apps); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	switch o := out.(type) {
+	case nil:
+	case *[]byte:
+		if *o, err = io.ReadAll(resp.Body); err != nil {
+			return nil, fmt.Errorf("failed to read response: %w", err)
+		}
+	default:
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
 	}
 
-	return apps, nil
+	return resp.Header, nil
 }
 
-// GetApp returns a specific application by its ID
-func (c *humanitecClient) GetApp(name string) (*App, error) {
-	if err := c.Validate(); err != nil {
-		return nil, err
+// containsStatus reports whether status is one of the expected status codes
+func containsStatus(expected []int, status int) bool {
+	for _, s := range expected {
+		if s == status {
+			return true
+		}
 	}
+	return false
+}
 
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/orgs/%s/apps/%s", c.baseURL, c.org, name), nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// newAPIError builds an APIError from an unsuccessful response,
+// using the message from the Humanitec error body when one is present
+func newAPIError(resp *http.Response) *APIError {
+	var body struct {
+		Message string `json:"message"`
 	}
+	// The error body is optional, so a decode failure just leaves the message empty
+	_ = json.NewDecoder(resp.Body).Decode(&body)
+	return &APIError{StatusCode: resp.StatusCode, Message: body.Message}
+}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
+// GetApps returns a list of applications
+func (c *humanitecClient) GetApps() ([]App, error) {
+	var apps []App
+	err := c.WalkApps(func(app App) error {
+		apps = append(apps, app)
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
+	return apps, nil
+}
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("application not found")
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
-	}
+// WalkApps calls fn for every application as pages arrive from the API
+func (c *humanitecClient) WalkApps(fn func(App) error) error {
+	return walk(c, fmt.Sprintf("/orgs/%s/apps", c.org), fn)
+}
 
+// GetApp returns a specific application by its ID
+func (c *humanitecClient) GetApp(name string) (*App, error) {
 	var app App
-	if err := json.NewDecoder(resp.Body).Decode(&app); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s", c.org, name), nil, &app, http.StatusOK); err != nil {
+		if IsNotFound(err) {
+			return nil, notFound("application")
+		}
+		return nil, err
 	}
-
 	return &app, nil
 }
 
 // CreateApp creates a new application in the organization
 func (c *humanitecClient) CreateApp(id string, name string, skipEnvCreation bool) (*App, error) {
-	if err := c.Validate(); err != nil {
-		return nil, err
-	}
-
-	payload := struct {
-		ID                    string `json:"id"`
-		Name                  string `json:"name"`
-		SkipEnvCreation      bool   `json:"skip_environment_creation"`
-	}{
-		ID:                    id,
-		Name:                  name,
-		SkipEnvCreation:      skipEnvCreation,
-	}
-
-	jsonData, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/orgs/%s/apps", c.baseURL, c.org), bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
-	}
+	req := CreateAppRequest(c.org, id, name, skipEnvCreation)
 
 	var app App
-	if err := json.NewDecoder(resp.Body).Decode(&app); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := c.do(req.Method, req.Path, req.Body, &app, http.StatusCreated); err != nil {
+		return nil, err
 	}
-
 	return &app, nil
 }
 
 // DeleteApp deletes an application by its ID
 func (c *humanitecClient) DeleteApp(name string) error {
-	if err := c.Validate(); err != nil {
-		return err
-	}
-
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/orgs/%s/apps/%s", c.baseURL, c.org, name), nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("API request failed with status %d", resp.StatusCode)
-	}
-
-	return nil
+	req := DeleteAppRequest(c.org, name)
+	return c.do(req.Method, req.Path, req.Body, nil, http.StatusNoContent, http.StatusAccepted)
 }
 
 // UpdateApp updates an application's name by its ID
 func (c *humanitecClient) UpdateApp(oldName string, newName string) (*App, error) {
-	if err := c.Validate(); err != nil {
-		return nil, err
-	}
-
-	payload := struct {
-		Name string `json:"name"`
-	}{
-		Name: newName,
-	}
-
-	jsonData, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/orgs/%s/apps/%s", c.baseURL, c.org, oldName), bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
-	}
+	req := UpdateAppRequest(c.org, oldName, newName)
 
 	var app App
-	if err := json.NewDecoder(resp.Body).Decode(&app); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := c.do(req.Method, req.Path, req.Body, &app, http.StatusOK); err != nil {
+		return nil, err
 	}
-
 	return &app, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,104 @@
+package humanitec
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Delta describes changes to apply to a deployment set
+type Delta struct {
+	ID       string        `json:"id,omitempty" yaml:"id,omitempty"`
+	Metadata DeltaMetadata `json:"metadata" yaml:"metadata"`
+	Modules  DeltaModules  `json:"modules" yaml:"modules"`
+	// Shared are JSON Patch operations on the shared resources of the deployment set
+	Shared []interface{} `json:"shared,omitempty" yaml:"shared,omitempty"`
+}
+
+// DeltaMetadata holds the descriptive fields of a delta
+type DeltaMetadata struct {
+	// EnvID is the environment the delta is intended for
+	EnvID     string `json:"env_id,omitempty" yaml:"env_id,omitempty"`
+	Name      string `json:"name,omitempty" yaml:"name,omitempty"`
+	CreatedAt string `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	CreatedBy string `json:"created_by,omitempty" yaml:"created_by,omitempty"`
+	// LastModifiedAt is the time of the last change to the delta
+	LastModifiedAt string `json:"last_modified_at,omitempty" yaml:"last_modified_at,omitempty"`
+	Archived       bool   `json:"archived,omitempty" yaml:"archived,omitempty"`
+}
+
+// DeltaModules lists the workloads added, removed and updated by a delta.
+// Updates are JSON Patch operations keyed by workload ID.
+type DeltaModules struct {
+	Add    map[string]interface{}   `json:"add,omitempty" yaml:"add,omitempty"`
+	Remove []string                 `json:"remove,omitempty" yaml:"remove,omitempty"`
+	Update map[string][]interface{} `json:"update,omitempty" yaml:"update,omitempty"`
+}
+
+// GetDeltas returns the deltas of an application. If envID is set, only the deltas intended
+// for that environment are returned. Archived deltas are returned instead of active ones if
+// archived is true.
+func (c *humanitecClient) GetDeltas(appID, envID string, archived bool) ([]Delta, error) {
+	query := url.Values{}
+	if envID != "" {
+		query.Set("env", envID)
+	}
+	query.Set("archived", strconv.FormatBool(archived))
+
+	var deltas []Delta
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s/deltas?%s", c.org, appID, query.Encode()), nil, &deltas, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return deltas, nil
+}
+
+// GetDelta returns a specific delta of an application
+func (c *humanitecClient) GetDelta(appID, deltaID string) (*Delta, error) {
+	var delta Delta
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s/deltas/%s", c.org, appID, deltaID), nil, &delta, http.StatusOK); err != nil {
+		if IsNotFound(err) {
+			return nil, notFound("delta")
+		}
+		return nil, err
+	}
+	return &delta, nil
+}
+
+// CreateDelta creates a delta in an application
+func (c *humanitecClient) CreateDelta(appID string, delta Delta) (*Delta, error) {
+	req := CreateDeltaRequest(c.org, appID, delta)
+
+	var created Delta
+	if err := c.do(req.Method, req.Path, req.Body, &created, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateDelta replaces the content of a delta
+func (c *humanitecClient) UpdateDelta(appID, deltaID string, delta Delta) (*Delta, error) {
+	req := UpdateDeltaRequest(c.org, appID, deltaID, delta)
+
+	var updated Delta
+	if err := c.do(req.Method, req.Path, req.Body, &updated, http.StatusOK); err != nil {
+		if IsNotFound(err) {
+			return nil, notFound("delta")
+		}
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// ArchiveDelta archives a delta so that it is no longer listed with the active deltas
+func (c *humanitecClient) ArchiveDelta(appID, deltaID string) error {
+	req := ArchiveDeltaRequest(c.org, appID, deltaID)
+
+	if err := c.do(req.Method, req.Path, req.Body, nil, http.StatusNoContent); err != nil {
+		if IsNotFound(err) {
+			return notFound("delta")
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package humanitec
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Deployment statuses
+const (
+	DeploymentPending    = "pending"
+	DeploymentInProgress = "in progress"
+	DeploymentSucceeded  = "succeeded"
+	DeploymentFailed     = "failed"
+)
+
+// Deployment represents a deployment of a deployment set to an environment
+type Deployment struct {
+	ID    string `json:"id" yaml:"id"`
+	EnvID string `json:"env_id" yaml:"env_id"`
+	// SetID is the deployment set that was deployed
+	SetID string `json:"set_id" yaml:"set_id"`
+	// DeltaID is the delta the deployment was created from, if any
+	DeltaID string `json:"delta_id,omitempty" yaml:"delta_id,omitempty"`
+	// FromID is the deployment this deployment was based on
+	FromID  string `json:"from_id,omitempty" yaml:"from_id,omitempty"`
+	Comment string `json:"comment,omitempty" yaml:"comment,omitempty"`
+	// Status is one of "pending", "in progress", "succeeded" or "failed"
+	Status          string `json:"status" yaml:"status"`
+	StatusChangedAt string `json:"status_changed_at,omitempty" yaml:"status_changed_at,omitempty"`
+	CreatedAt       string `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	CreatedBy       string `json:"created_by,omitempty" yaml:"created_by,omitempty"`
+}
+
+// IsDone reports whether the deployment has finished, successfully or not
+func (d *Deployment) IsDone() bool {
+	return d.Status == DeploymentSucceeded || d.Status == DeploymentFailed
+}
+
+// DeploymentError describes why a deployment failed for a single object
+type DeploymentError struct {
+	// Scope is the kind of object that failed, e.g. "workload" or "resource"
+	Scope    string `json:"scope" yaml:"scope"`
+	ObjectID string `json:"object_id" yaml:"object_id"`
+	Code     string `json:"code,omitempty" yaml:"code,omitempty"`
+	Summary  string `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Message  string `json:"message" yaml:"message"`
+	// CreatedAt is when the error was reported
+	CreatedAt string `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+// DeploymentLog is a log line written by a step of a deployment
+type DeploymentLog struct {
+	// Step is the deployment step that wrote the line, e.g. "provision" or "deploy"
+	Step      string `json:"step" yaml:"step"`
+	Timestamp string `json:"timestamp" yaml:"timestamp"`
+	// Level is the severity of the line, e.g. "info" or "error"
+	Level   string `json:"level,omitempty" yaml:"level,omitempty"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// DeploymentRequest describes a deployment to start. Either DeltaID or SetID is set.
+type DeploymentRequest struct {
+	DeltaID string `json:"delta_id,omitempty" yaml:"delta_id,omitempty"`
+	SetID   string `json:"set_id,omitempty" yaml:"set_id,omitempty"`
+	Comment string `json:"comment,omitempty" yaml:"comment,omitempty"`
+}
+
+// CreateDeployment starts a deployment to an environment
+func (c *humanitecClient) CreateDeployment(appID, envID string, req DeploymentRequest) (*Deployment, error) {
+	r := CreateDeploymentRequest(c.org, appID, envID, req)
+
+	var deployment Deployment
+	if err := c.do(r.Method, r.Path, r.Body, &deployment, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return &deployment, nil
+}
+
+// GetDeployments returns the deployments of an environment, most recent first
+func (c *humanitecClient) GetDeployments(appID, envID string) ([]Deployment, error) {
+	var deployments []Deployment
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s/envs/%s/deploys", c.org, appID, envID), nil, &deployments, http.StatusOK); err != nil {
+		if IsNotFound(err) {
+			return nil, notFound("environment")
+		}
+		return nil, err
+	}
+	return deployments, nil
+}
+
+// GetDeployment returns a specific deployment of an environment
+func (c *humanitecClient) GetDeployment(appID, envID, deployID string) (*Deployment, error) {
+	var deployment Deployment
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s/envs/%s/deploys/%s", c.org, appID, envID, deployID), nil, &deployment, http.StatusOK); err != nil {
+		if IsNotFound(err) {
+			return nil, notFound("deployment")
+		}
+		return nil, err
+	}
+	return &deployment, nil
+}
+
+// GetDeploymentErrors returns the errors of a failed deployment
+func (c *humanitecClient) GetDeploymentErrors(appID, envID, deployID string) ([]DeploymentError, error) {
+	var deploymentErrors []DeploymentError
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s/envs/%s/deploys/%s/errors", c.org, appID, envID, deployID), nil, &deploymentErrors, http.StatusOK); err != nil {
+		if IsNotFound(err) {
+			return nil, notFound("deployment")
+		}
+		return nil, err
+	}
+	return deploymentErrors, nil
+}
+
+// GetDeploymentLogs returns the log lines of the steps of a deployment, oldest first
+func (c *humanitecClient) GetDeploymentLogs(appID, envID, deployID string) ([]DeploymentLog, error) {
+	var logs []DeploymentLog
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s/envs/%s/deploys/%s/logs", c.org, appID, envID, deployID), nil, &logs, http.StatusOK); err != nil {
+		if IsNotFound(err) {
+			return nil, notFound("deployment")
+		}
+		return nil, err
+	}
+	return logs, nil
+}
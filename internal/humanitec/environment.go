@@ -0,0 +1,249 @@
+package humanitec
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// Environment represents an environment of a Humanitec application
+type Environment struct {
+	ID   string `json:"id" yaml:"id"`
+	Name string `json:"name" yaml:"name"`
+	Type string `json:"type" yaml:"type"`
+	// CreatedAt is the RFC 3339 timestamp at which the environment was created
+	CreatedAt string `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	// CreatedBy is the ID of the user who created the environment
+	CreatedBy string `json:"created_by,omitempty" yaml:"created_by,omitempty"`
+	// FromDeployID is the deployment the environment was cloned from, if any
+	FromDeployID string `json:"from_deploy_id,omitempty" yaml:"from_deploy_id,omitempty"`
+	// LastDeploy is the most recent deployment to the environment
+	LastDeploy *Deployment `json:"last_deploy,omitempty" yaml:"last_deploy,omitempty"`
+}
+
+// GetEnvs returns the environments of an application
+func (c *humanitecClient) GetEnvs(appID string) ([]Environment, error) {
+	var envs []Environment
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s/envs", c.org, appID), nil, &envs, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return envs, nil
+}
+
+// GetEnv returns a specific environment of an application
+func (c *humanitecClient) GetEnv(appID, envID string) (*Environment, error) {
+	var env Environment
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s/envs/%s", c.org, appID, envID), nil, &env, http.StatusOK); err != nil {
+		if IsNotFound(err) {
+			return nil, notFound("environment")
+		}
+		return nil, err
+	}
+	return &env, nil
+}
+
+// CreateEnv creates an environment in an application
+func (c *humanitecClient) CreateEnv(appID string, env Environment) (*Environment, error) {
+	req := CreateEnvRequest(c.org, appID, env)
+
+	var created Environment
+	if err := c.do(req.Method, req.Path, req.Body, &created, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateEnv renames an environment of an application
+func (c *humanitecClient) UpdateEnv(appID, envID, name string) (*Environment, error) {
+	req := UpdateEnvRequest(c.org, appID, envID, name)
+
+	var env Environment
+	if err := c.do(req.Method, req.Path, req.Body, &env, http.StatusOK); err != nil {
+		if IsNotFound(err) {
+			return nil, notFound("environment")
+		}
+		return nil, err
+	}
+	return &env, nil
+}
+
+// DeleteEnv deletes an environment of an application
+func (c *humanitecClient) DeleteEnv(appID, envID string) error {
+	req := DeleteEnvRequest(c.org, appID, envID)
+	if err := c.do(req.Method, req.Path, req.Body, nil, http.StatusNoContent, http.StatusAccepted); err != nil {
+		if IsNotFound(err) {
+			return notFound("environment")
+		}
+		return err
+	}
+	return nil
+}
+
+// EnvironmentType represents an environment type of the organization, e.g. development
+type EnvironmentType struct {
+	ID          string `json:"id" yaml:"id"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// GetEnvTypes returns the environment types of the organization
+func (c *humanitecClient) GetEnvTypes() ([]EnvironmentType, error) {
+	var envTypes []EnvironmentType
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/env-types", c.org), nil, &envTypes, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return envTypes, nil
+}
+
+// CreateEnvType creates an environment type in the organization
+func (c *humanitecClient) CreateEnvType(envType EnvironmentType) (*EnvironmentType, error) {
+	req := CreateEnvTypeRequest(c.org, envType)
+
+	var created EnvironmentType
+	if err := c.do(req.Method, req.Path, req.Body, &created, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// DeleteEnvType deletes an environment type of the organization
+func (c *humanitecClient) DeleteEnvType(id string) error {
+	req := DeleteEnvTypeRequest(c.org, id)
+	if err := c.do(req.Method, req.Path, req.Body, nil, http.StatusOK, http.StatusNoContent); err != nil {
+		if IsNotFound(err) {
+			return notFound("environment type")
+		}
+		return err
+	}
+	return nil
+}
+
+// EnvRuntime describes the runtime of an environment in its Kubernetes cluster
+type EnvRuntime struct {
+	// Namespace is the Kubernetes namespace the environment is deployed to
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// Paused reports whether the workloads of the environment are scaled down
+	Paused bool `json:"paused" yaml:"paused"`
+	// Modules holds the runtime state of the controllers of each workload by workload ID
+	Modules map[string]ModuleRuntime `json:"modules,omitempty" yaml:"modules,omitempty"`
+}
+
+// ModuleRuntime is the state of the Kubernetes controllers of a workload
+type ModuleRuntime struct {
+	// Controllers holds the state of each controller by name, which is usually the workload ID
+	Controllers map[string]ControllerRuntime `json:"controllers" yaml:"controllers"`
+}
+
+// ControllerRuntime is the state of a Kubernetes controller and its pods
+type ControllerRuntime struct {
+	// Kind is the kind of the controller, e.g. "Deployment"
+	Kind     string `json:"kind" yaml:"kind"`
+	Replicas int    `json:"replicas" yaml:"replicas"`
+	Revision int    `json:"revision,omitempty" yaml:"revision,omitempty"`
+	// Status summarizes the health of the controller, e.g. "Success", "Pending" or "Failure"
+	Status  string       `json:"status" yaml:"status"`
+	Message string       `json:"message,omitempty" yaml:"message,omitempty"`
+	Pods    []PodRuntime `json:"pods" yaml:"pods"`
+}
+
+// PodRuntime is the state of a single pod of a controller
+type PodRuntime struct {
+	Name     string `json:"podName" yaml:"podName"`
+	Revision int    `json:"revision,omitempty" yaml:"revision,omitempty"`
+	// Phase is the Kubernetes pod phase, e.g. "Running" or "Pending"
+	Phase             string            `json:"phase" yaml:"phase"`
+	Status            string            `json:"status,omitempty" yaml:"status,omitempty"`
+	ContainerStatuses []ContainerStatus `json:"containerStatuses" yaml:"containerStatuses"`
+}
+
+// ContainerStatus is the state of a single container of a pod
+type ContainerStatus struct {
+	Name         string `json:"name" yaml:"name"`
+	Ready        bool   `json:"ready" yaml:"ready"`
+	RestartCount int    `json:"restartCount" yaml:"restartCount"`
+}
+
+// Ready reports whether every container of the pod is ready
+func (p *PodRuntime) Ready() bool {
+	for _, container := range p.ContainerStatuses {
+		if !container.Ready {
+			return false
+		}
+	}
+	return len(p.ContainerStatuses) > 0
+}
+
+// Restarts returns the number of restarts of all containers of the pod
+func (p *PodRuntime) Restarts() int {
+	restarts := 0
+	for _, container := range p.ContainerStatuses {
+		restarts += container.RestartCount
+	}
+	return restarts
+}
+
+// WorkloadRuntime is the state of one controller of a workload
+type WorkloadRuntime struct {
+	// ID is the workload ID, followed by "/" and the controller name if the two differ
+	ID                string `json:"id" yaml:"id"`
+	ControllerRuntime `yaml:",inline"`
+}
+
+// Workloads returns the state of the controllers of every workload of the environment ordered by ID
+func (r *EnvRuntime) Workloads() []WorkloadRuntime {
+	var workloads []WorkloadRuntime
+	for moduleID, module := range r.Modules {
+		for name, controller := range module.Controllers {
+			id := moduleID
+			if name != moduleID {
+				id += "/" + name
+			}
+			workloads = append(workloads, WorkloadRuntime{ID: id, ControllerRuntime: controller})
+		}
+	}
+	sort.Slice(workloads, func(i, j int) bool { return workloads[i].ID < workloads[j].ID })
+	return workloads
+}
+
+// ReadyPods returns the number of pods of the workload that are ready
+func (w *WorkloadRuntime) ReadyPods() int {
+	ready := 0
+	for _, pod := range w.Pods {
+		if pod.Ready() {
+			ready++
+		}
+	}
+	return ready
+}
+
+// Restarts returns the number of container restarts of all pods of the workload
+func (w *WorkloadRuntime) Restarts() int {
+	restarts := 0
+	for _, pod := range w.Pods {
+		restarts += pod.Restarts()
+	}
+	return restarts
+}
+
+// GetEnvRuntime returns the runtime of an environment
+func (c *humanitecClient) GetEnvRuntime(appID, envID string) (*EnvRuntime, error) {
+	var runtime EnvRuntime
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s/envs/%s/runtime", c.org, appID, envID), nil, &runtime, http.StatusOK); err != nil {
+		if IsNotFound(err) {
+			return nil, notFound("environment")
+		}
+		return nil, err
+	}
+	return &runtime, nil
+}
+
+// SetEnvPaused pauses or resumes the workloads of an environment
+func (c *humanitecClient) SetEnvPaused(appID, envID string, paused bool) error {
+	req := SetEnvPausedRequest(c.org, appID, envID, paused)
+	if err := c.do(req.Method, req.Path, req.Body, nil, http.StatusNoContent); err != nil {
+		if IsNotFound(err) {
+			return notFound("environment")
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package humanitec
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// runtimeResponse is a response of the environment runtime endpoint in the shape of the
+// RuntimeInfoResponse schema of the Humanitec API, with controllers nested under each module
+const runtimeResponse = `{
+  "namespace": "5f3b1c2d-development",
+  "paused": false,
+  "modules": {
+    "api": {
+      "controllers": {
+        "api": {
+          "kind": "Deployment",
+          "message": "",
+          "replicas": 2,
+          "revision": 3,
+          "status": "Success",
+          "pods": [
+            {
+              "podName": "api-7d9f8b6c5-abcde",
+              "revision": 3,
+              "phase": "Running",
+              "status": "Success",
+              "containerStatuses": [
+                {"name": "api", "ready": true, "restartCount": 0, "state": {"running": {"startedAt": "2024-01-02T10:00:00Z"}}},
+                {"name": "sidecar", "ready": true, "restartCount": 1, "state": {"running": {"startedAt": "2024-01-02T10:01:00Z"}}}
+              ]
+            },
+            {
+              "podName": "api-7d9f8b6c5-fghij",
+              "revision": 3,
+              "phase": "Running",
+              "status": "Pending",
+              "containerStatuses": [
+                {"name": "api", "ready": false, "restartCount": 2, "state": {"waiting": {"reason": "CrashLoopBackOff"}}},
+                {"name": "sidecar", "ready": true, "restartCount": 0, "state": {"running": {"startedAt": "2024-01-02T10:00:00Z"}}}
+              ]
+            }
+          ]
+        }
+      }
+    },
+    "batch": {
+      "controllers": {
+        "batch-cleanup": {
+          "kind": "CronJob",
+          "replicas": 0,
+          "revision": 1,
+          "status": "Success",
+          "pods": []
+        }
+      }
+    }
+  }
+}`
+
+// TestGetEnvRuntime verifies that the runtime of an environment is decoded from the API
+// response and flattened into one workload per controller
+func TestGetEnvRuntime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/orgs/test-org/apps/test-app/envs/development/runtime", r.URL.Path)
+		fmt.Fprint(w, runtimeResponse)
+	}))
+	defer server.Close()
+
+	client := &humanitecClient{apiToken: "test-token", baseURL: server.URL, org: "test-org", client: server.Client()}
+	runtime, err := client.GetEnvRuntime("test-app", "development")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "5f3b1c2d-development", runtime.Namespace)
+	workloads := runtime.Workloads()
+	if assert.Len(t, workloads, 2) {
+		api := workloads[0]
+		assert.Equal(t, "api", api.ID)
+		assert.Equal(t, "Deployment", api.Kind)
+		assert.Equal(t, 2, api.Replicas)
+		assert.Equal(t, "Success", api.Status)
+		assert.Equal(t, "api-7d9f8b6c5-abcde", api.Pods[0].Name)
+		assert.Equal(t, 1, api.ReadyPods())
+		assert.Equal(t, 3, api.Restarts())
+
+		batch := workloads[1]
+		assert.Equal(t, "batch/batch-cleanup", batch.ID)
+		assert.Equal(t, "CronJob", batch.Kind)
+		assert.Equal(t, 0, batch.ReadyPods())
+	}
+}
@@ -1,8 +1,39 @@
 package humanitec
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
 
 var (
 	// ErrMissingAPIToken is returned when the API token is not set
 	ErrMissingAPIToken = errors.New("Humanitec API token is not set in config.yaml")
-) 
\ No newline at end of file
+)
+
+// APIError is returned when the Humanitec API responds with an unexpected status code
+type APIError struct {
+	// StatusCode is the HTTP status code returned by the API
+	StatusCode int
+	// Message is the error message returned by the API, if any
+	Message string
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("API request failed with status %d", e.StatusCode)
+}
+
+// notFound returns an APIError for a missing resource of the given kind
+func notFound(kind string) *APIError {
+	return &APIError{StatusCode: http.StatusNotFound, Message: fmt.Sprintf("%s not found", kind)}
+}
+
+// IsNotFound reports whether err is an APIError with a 404 status code
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
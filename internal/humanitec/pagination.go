@@ -0,0 +1,90 @@
+package humanitec
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// pageSize is the number of items requested per page from list endpoints
+const pageSize = 50
+
+// walk iterates over every item of a paginated list endpoint, calling fn for
+// each item as its page arrives. Pages are followed through the rel="next"
+// entry of the Link response header until the last page is reached.
+func walk[T any](c *humanitecClient, path string, fn func(T) error) error {
+	next, err := url.Parse(c.baseURL + path)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	query := next.Query()
+	query.Set("per_page", fmt.Sprint(pageSize))
+	next.RawQuery = query.Encode()
+
+	nextURL := next.String()
+	for nextURL != "" {
+		var page []T
+		header, err := c.request(http.MethodGet, nextURL, nil, &page, http.StatusOK)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range page {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+
+		nextURL, err = nextLink(c.baseURL, header.Get("Link"))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nextLink extracts the rel="next" URL from a Link header, resolving
+// relative links against the base URL. It returns "" on the last page.
+// Links to another scheme or host are rejected so that the API token is
+// never sent anywhere but the Humanitec API.
+func nextLink(baseURL, header string) (string, error) {
+	for _, part := range strings.Split(header, ",") {
+		segments := strings.Split(part, ";")
+		if len(segments) < 2 {
+			continue
+		}
+
+		isNext := false
+		for _, param := range segments[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				isNext = true
+				break
+			}
+		}
+		if !isNext {
+			continue
+		}
+
+		link := strings.Trim(strings.TrimSpace(segments[0]), "<>")
+		if strings.HasPrefix(link, "/") && !strings.HasPrefix(link, "//") {
+			return baseURL + link, nil
+		}
+
+		base, err := url.Parse(baseURL)
+		if err != nil {
+			return "", fmt.Errorf("invalid base URL: %w", err)
+		}
+		ref, err := url.Parse(link)
+		if err != nil {
+			return "", fmt.Errorf("invalid next page link %q: %w", link, err)
+		}
+		next := base.ResolveReference(ref)
+		if next.Scheme != base.Scheme || next.Host != base.Host {
+			return "", fmt.Errorf("refusing to follow next page link to %s://%s: expected %s://%s", next.Scheme, next.Host, base.Scheme, base.Host)
+		}
+		return next.String(), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,99 @@
+package humanitec
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNextLink verifies that next page links are resolved against the base URL and that
+// links to another scheme or host are rejected
+func TestNextLink(t *testing.T) {
+	const baseURL = "https://api.humanitec.io"
+
+	testCases := []struct {
+		name        string
+		header      string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:     "last page",
+			header:   "",
+			expected: "",
+		},
+		{
+			name:     "no next relation",
+			header:   `<https://api.humanitec.io/orgs/test-org/apps?page=1>; rel="prev"`,
+			expected: "",
+		},
+		{
+			name:     "absolute link",
+			header:   `<https://api.humanitec.io/orgs/test-org/apps?page=1>; rel="prev", <https://api.humanitec.io/orgs/test-org/apps?page=3>; rel="next"`,
+			expected: "https://api.humanitec.io/orgs/test-org/apps?page=3",
+		},
+		{
+			name:     "relative link",
+			header:   `</orgs/test-org/apps?page=2>; rel="next"`,
+			expected: "https://api.humanitec.io/orgs/test-org/apps?page=2",
+		},
+		{
+			name:        "other host",
+			header:      `<https://attacker.example.com/orgs/test-org/apps?page=2>; rel="next"`,
+			expectedErr: true,
+		},
+		{
+			name:        "protocol-relative link to other host",
+			header:      `<//attacker.example.com/orgs/test-org/apps?page=2>; rel="next"`,
+			expectedErr: true,
+		},
+		{
+			name:        "other scheme",
+			header:      `<http://api.humanitec.io/orgs/test-org/apps?page=2>; rel="next"`,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := nextLink(baseURL, tc.header)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+// TestWalkRefusesForeignNextLink verifies that the API token is not sent to a host named
+// in a next page link
+func TestWalkRefusesForeignNextLink(t *testing.T) {
+	foreignCalled := false
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		foreignCalled = true
+		fmt.Fprint(w, "[]")
+	}))
+	defer foreign.Close()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", fmt.Sprintf(`<%s/orgs/test-org/apps?page=2>; rel="next"`, foreign.URL))
+		fmt.Fprint(w, `[{"id": "test-app", "name": "Test App"}]`)
+	}))
+	defer api.Close()
+
+	client := &humanitecClient{apiToken: "test-token", baseURL: api.URL, org: "test-org", client: api.Client()}
+
+	var ids []string
+	err := walk(client, "/orgs/test-org/apps", func(app App) error {
+		ids = append(ids, app.ID)
+		return nil
+	})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"test-app"}, ids)
+	assert.False(t, foreignCalled, "the next page link to another host should not be followed")
+}
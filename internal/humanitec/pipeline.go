@@ -0,0 +1,52 @@
+package humanitec
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// pipelineContentType is the media type of pipeline definitions
+const pipelineContentType = "application/x.humanitec-pipelines-v1.0+yaml"
+
+// Pipeline represents a pipeline attached to an application
+type Pipeline struct {
+	ID        string `json:"id" yaml:"id"`
+	Name      string `json:"name" yaml:"name"`
+	AppID     string `json:"app_id" yaml:"app_id"`
+	Status    string `json:"status" yaml:"status"`
+	Version   string `json:"version,omitempty" yaml:"version,omitempty"`
+	CreatedAt string `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+// GetPipelines returns the pipelines of an application
+func (c *humanitecClient) GetPipelines(appID string) ([]Pipeline, error) {
+	var pipelines []Pipeline
+	err := walk(c, fmt.Sprintf("/orgs/%s/apps/%s/pipelines", c.org, appID), func(pipeline Pipeline) error {
+		pipelines = append(pipelines, pipeline)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pipelines, nil
+}
+
+// GetPipelineDefinition returns the YAML definition of a pipeline
+func (c *humanitecClient) GetPipelineDefinition(appID, pipelineID string) (string, error) {
+	var definition []byte
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s/pipelines/%s/schema", c.org, appID, pipelineID), nil, &definition, http.StatusOK); err != nil {
+		return "", err
+	}
+	return string(definition), nil
+}
+
+// CreatePipeline creates a pipeline in an application from its YAML definition
+func (c *humanitecClient) CreatePipeline(appID, definition string) (*Pipeline, error) {
+	body := rawBody{contentType: pipelineContentType, data: []byte(definition)}
+
+	var pipeline Pipeline
+	if err := c.do(http.MethodPost, fmt.Sprintf("/orgs/%s/apps/%s/pipelines", c.org, appID), body, &pipeline, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return &pipeline, nil
+}
@@ -0,0 +1,154 @@
+package humanitec
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Request describes an API request without sending it. Client methods build
+// their requests with the functions below, so a dry run prints exactly what
+// would be sent.
+type Request struct {
+	Method string      `json:"method" yaml:"method"`
+	Path   string      `json:"path" yaml:"path"`
+	Body   interface{} `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// createAppPayload is the request body for creating an application
+type createAppPayload struct {
+	ID              string `json:"id" yaml:"id"`
+	Name            string `json:"name" yaml:"name"`
+	SkipEnvCreation bool   `json:"skip_environment_creation" yaml:"skip_environment_creation"`
+}
+
+// updateAppPayload is the request body for updating an application
+type updateAppPayload struct {
+	Name string `json:"name" yaml:"name"`
+}
+
+// createEnvPayload is the request body for creating an environment
+type createEnvPayload struct {
+	ID           string `json:"id" yaml:"id"`
+	Name         string `json:"name" yaml:"name"`
+	Type         string `json:"type" yaml:"type"`
+	FromDeployID string `json:"from_deploy_id,omitempty" yaml:"from_deploy_id,omitempty"`
+}
+
+// updateEnvPayload is the request body for updating an environment
+type updateEnvPayload struct {
+	Name string `json:"name" yaml:"name"`
+}
+
+// CreateAppRequest returns the request that creates an application
+func CreateAppRequest(org, id, name string, skipEnvCreation bool) Request {
+	return Request{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("/orgs/%s/apps", org),
+		Body:   createAppPayload{ID: id, Name: name, SkipEnvCreation: skipEnvCreation},
+	}
+}
+
+// UpdateAppRequest returns the request that renames an application
+func UpdateAppRequest(org, id, name string) Request {
+	return Request{
+		Method: http.MethodPatch,
+		Path:   fmt.Sprintf("/orgs/%s/apps/%s", org, id),
+		Body:   updateAppPayload{Name: name},
+	}
+}
+
+// DeleteAppRequest returns the request that deletes an application
+func DeleteAppRequest(org, id string) Request {
+	return Request{
+		Method: http.MethodDelete,
+		Path:   fmt.Sprintf("/orgs/%s/apps/%s", org, id),
+	}
+}
+
+// CreateEnvRequest returns the request that creates an environment in an application
+func CreateEnvRequest(org, appID string, env Environment) Request {
+	return Request{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("/orgs/%s/apps/%s/envs", org, appID),
+		Body:   createEnvPayload{ID: env.ID, Name: env.Name, Type: env.Type, FromDeployID: env.FromDeployID},
+	}
+}
+
+// UpdateEnvRequest returns the request that renames an environment
+func UpdateEnvRequest(org, appID, envID, name string) Request {
+	return Request{
+		Method: http.MethodPatch,
+		Path:   fmt.Sprintf("/orgs/%s/apps/%s/envs/%s", org, appID, envID),
+		Body:   updateEnvPayload{Name: name},
+	}
+}
+
+// DeleteEnvRequest returns the request that deletes an environment
+func DeleteEnvRequest(org, appID, envID string) Request {
+	return Request{
+		Method: http.MethodDelete,
+		Path:   fmt.Sprintf("/orgs/%s/apps/%s/envs/%s", org, appID, envID),
+	}
+}
+
+// CreateEnvTypeRequest returns the request that creates an environment type
+func CreateEnvTypeRequest(org string, envType EnvironmentType) Request {
+	return Request{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("/orgs/%s/env-types", org),
+		Body:   envType,
+	}
+}
+
+// DeleteEnvTypeRequest returns the request that deletes an environment type
+func DeleteEnvTypeRequest(org, id string) Request {
+	return Request{
+		Method: http.MethodDelete,
+		Path:   fmt.Sprintf("/orgs/%s/env-types/%s", org, id),
+	}
+}
+
+// SetEnvPausedRequest returns the request that pauses or resumes an environment
+func SetEnvPausedRequest(org, appID, envID string, paused bool) Request {
+	return Request{
+		Method: http.MethodPut,
+		Path:   fmt.Sprintf("/orgs/%s/apps/%s/envs/%s/runtime/paused", org, appID, envID),
+		Body:   paused,
+	}
+}
+
+// CreateDeploymentRequest returns the request that deploys a delta or deployment set to an environment
+func CreateDeploymentRequest(org, appID, envID string, req DeploymentRequest) Request {
+	return Request{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("/orgs/%s/apps/%s/envs/%s/deploys", org, appID, envID),
+		Body:   req,
+	}
+}
+
+// CreateDeltaRequest returns the request that creates a delta in an application
+func CreateDeltaRequest(org, appID string, delta Delta) Request {
+	return Request{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("/orgs/%s/apps/%s/deltas", org, appID),
+		Body:   delta,
+	}
+}
+
+// UpdateDeltaRequest returns the request that replaces the content of a delta
+func UpdateDeltaRequest(org, appID, deltaID string, delta Delta) Request {
+	return Request{
+		Method: http.MethodPut,
+		Path:   fmt.Sprintf("/orgs/%s/apps/%s/deltas/%s", org, appID, deltaID),
+		Body:   delta,
+	}
+}
+
+// ArchiveDeltaRequest returns the request that archives a delta
+func ArchiveDeltaRequest(org, appID, deltaID string) Request {
+	return Request{
+		Method: http.MethodPut,
+		Path:   fmt.Sprintf("/orgs/%s/apps/%s/deltas/%s/metadata/archived", org, appID, deltaID),
+		Body:   true,
+	}
+}
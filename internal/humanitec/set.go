@@ -0,0 +1,36 @@
+package humanitec
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// DeploymentSet is the immutable configuration of an environment: its workloads
+// (modules) and shared resources. Sets are identified by a hash of their content.
+type DeploymentSet struct {
+	ID      string                 `json:"id" yaml:"id"`
+	Modules map[string]interface{} `json:"modules" yaml:"modules"`
+	Shared  map[string]interface{} `json:"shared,omitempty" yaml:"shared,omitempty"`
+	Version int                    `json:"version,omitempty" yaml:"version,omitempty"`
+}
+
+// GetSets returns the deployment sets of an application
+func (c *humanitecClient) GetSets(appID string) ([]DeploymentSet, error) {
+	var sets []DeploymentSet
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s/sets", c.org, appID), nil, &sets, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return sets, nil
+}
+
+// GetSet returns a deployment set of an application
+func (c *humanitecClient) GetSet(appID, setID string) (*DeploymentSet, error) {
+	var set DeploymentSet
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s/sets/%s", c.org, appID, setID), nil, &set, http.StatusOK); err != nil {
+		if IsNotFound(err) {
+			return nil, notFound("deployment set")
+		}
+		return nil, err
+	}
+	return &set, nil
+}
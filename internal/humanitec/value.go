@@ -0,0 +1,33 @@
+package humanitec
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Value represents a shared value of an application.
+// The value of a secret is never returned by the API.
+type Value struct {
+	Key         string `json:"key" yaml:"key"`
+	Value       string `json:"value" yaml:"value"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	IsSecret    bool   `json:"is_secret" yaml:"is_secret"`
+}
+
+// GetValues returns the shared values of an application
+func (c *humanitecClient) GetValues(appID string) ([]Value, error) {
+	var values []Value
+	if err := c.do(http.MethodGet, fmt.Sprintf("/orgs/%s/apps/%s/values", c.org, appID), nil, &values, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// CreateValue creates a shared value in an application
+func (c *humanitecClient) CreateValue(appID string, value Value) (*Value, error) {
+	var created Value
+	if err := c.do(http.MethodPost, fmt.Sprintf("/orgs/%s/apps/%s/values", c.org, appID), value, &created, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
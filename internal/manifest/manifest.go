@@ -0,0 +1,150 @@
+// Package manifest loads declarative application manifests and plans the
+// API calls needed to make the live state match them.
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/validation"
+	"gopkg.in/yaml.v3"
+)
+
+// KindApp is the kind of an application manifest
+const KindApp = "App"
+
+// Stdin is the path that reads manifests from standard input
+const Stdin = "-"
+
+// AppManifest is the desired state of an application
+type AppManifest struct {
+	Kind string `json:"kind" yaml:"kind"`
+	ID   string `json:"id" yaml:"id"`
+	Name string `json:"name" yaml:"name"`
+	// Source is the file the manifest was read from
+	Source string `json:"-" yaml:"-"`
+}
+
+// Load reads application manifests from a file, a directory of .yaml/.yml
+// files, or standard input when path is "-". Files may contain several
+// YAML documents separated by "---".
+func Load(path string, stdin io.Reader) ([]AppManifest, error) {
+	var manifests []AppManifest
+	var err error
+	if path == Stdin {
+		manifests, err = decode(stdin, "<stdin>")
+	} else {
+		manifests, err = loadPath(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(manifests); err != nil {
+		return nil, err
+	}
+	return manifests, nil
+}
+
+// loadPath reads manifests from a file or from every YAML file below a directory
+func loadPath(path string) ([]AppManifest, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifests: %w", err)
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		files, err = manifestFiles(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var manifests []AppManifest
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest %s: %w", file, err)
+		}
+		decoded, err := decode(bytes.NewReader(data), file)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, decoded...)
+	}
+	return manifests, nil
+}
+
+// manifestFiles returns the YAML files below dir in lexical order
+func manifestFiles(dir string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		ext := strings.ToLower(filepath.Ext(path))
+		if !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifests: %w", err)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// decode reads every YAML document from r, skipping empty documents and
+// rejecting fields that are not part of a manifest
+func decode(r io.Reader, source string) ([]AppManifest, error) {
+	var manifests []AppManifest
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	for {
+		var m AppManifest
+		err := decoder.Decode(&m)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, clierrors.Validationf("failed to parse manifest %s: %v", source, err)
+		}
+		if m == (AppManifest{}) {
+			continue
+		}
+		m.Source = source
+		manifests = append(manifests, m)
+	}
+	return manifests, nil
+}
+
+// validate checks that every manifest is a complete application manifest
+// and that no application is declared twice
+func validate(manifests []AppManifest) error {
+	seen := map[string]string{}
+	for _, m := range manifests {
+		if m.Kind != KindApp {
+			return clierrors.Validationf("%s: unsupported kind %q, expected %q", m.Source, m.Kind, KindApp)
+		}
+		if err := validation.ID(validation.App, m.ID); err != nil {
+			return clierrors.Validationf("%s: %v", m.Source, err)
+		}
+		if err := validation.Name(m.Name); err != nil {
+			return clierrors.Validationf("%s: application %s: %v", m.Source, m.ID, err)
+		}
+		if previous, ok := seen[m.ID]; ok {
+			return clierrors.Validationf("%s: application %s is already declared in %s", m.Source, m.ID, previous)
+		}
+		seen[m.ID] = m.Source
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeFile writes content to name below dir, creating parent directories
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+	return path
+}
+
+// TestLoad verifies that manifests are read from files with several documents, from
+// directories in lexical order and from standard input, skipping empty documents
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	file := writeFile(t, dir, "apps.yaml", "kind: App\nid: api\nname: API\n---\n---\nkind: App\nid: web\nname: Web\n")
+	writeFile(t, dir, "nested/worker.yml", "kind: App\nid: worker\nname: Worker\n")
+	writeFile(t, dir, "README.md", "not a manifest")
+
+	testCases := []struct {
+		name     string
+		path     string
+		stdin    string
+		expected []AppManifest
+	}{
+		{
+			name: "file with multiple documents",
+			path: file,
+			expected: []AppManifest{
+				{Kind: KindApp, ID: "api", Name: "API", Source: file},
+				{Kind: KindApp, ID: "web", Name: "Web", Source: file},
+			},
+		},
+		{
+			name: "directory",
+			path: dir,
+			expected: []AppManifest{
+				{Kind: KindApp, ID: "api", Name: "API", Source: file},
+				{Kind: KindApp, ID: "web", Name: "Web", Source: file},
+				{Kind: KindApp, ID: "worker", Name: "Worker", Source: filepath.Join(dir, "nested", "worker.yml")},
+			},
+		},
+		{
+			name:  "stdin",
+			path:  Stdin,
+			stdin: "kind: App\nid: api\nname: API\n",
+			expected: []AppManifest{
+				{Kind: KindApp, ID: "api", Name: "API", Source: "<stdin>"},
+			},
+		},
+		{
+			name:  "empty stdin",
+			path:  Stdin,
+			stdin: "---\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifests, err := Load(tc.path, strings.NewReader(tc.stdin))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, manifests)
+		})
+	}
+}
+
+// TestLoadErrors verifies that invalid manifests are reported as validation errors naming
+// their source, and that missing paths are reported as general errors
+func TestLoadErrors(t *testing.T) {
+	testCases := []struct {
+		name             string
+		stdin            string
+		expectedErr      string
+		expectedExitCode int
+	}{
+		{
+			name:             "unknown field",
+			stdin:            "kind: App\nid: api\nname: API\nreplicas: 2\n",
+			expectedErr:      "failed to parse manifest <stdin>",
+			expectedExitCode: clierrors.ExitValidation,
+		},
+		{
+			name:             "invalid yaml",
+			stdin:            "kind: [App\n",
+			expectedErr:      "failed to parse manifest <stdin>",
+			expectedExitCode: clierrors.ExitValidation,
+		},
+		{
+			name:             "unsupported kind",
+			stdin:            "kind: Environment\nid: api\nname: API\n",
+			expectedErr:      `<stdin>: unsupported kind "Environment", expected "App"`,
+			expectedExitCode: clierrors.ExitValidation,
+		},
+		{
+			name:             "invalid id",
+			stdin:            "kind: App\nid: My_App\nname: API\n",
+			expectedErr:      `<stdin>: invalid application id "My_App"`,
+			expectedExitCode: clierrors.ExitValidation,
+		},
+		{
+			name:             "empty name",
+			stdin:            "kind: App\nid: api\n",
+			expectedErr:      "<stdin>: application api:",
+			expectedExitCode: clierrors.ExitValidation,
+		},
+		{
+			name:             "duplicate id",
+			stdin:            "kind: App\nid: api\nname: API\n---\nkind: App\nid: api\nname: Other\n",
+			expectedErr:      "<stdin>: application api is already declared in <stdin>",
+			expectedExitCode: clierrors.ExitValidation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifests, err := Load(Stdin, strings.NewReader(tc.stdin))
+			assert.Nil(t, manifests)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				assert.Equal(t, tc.expectedExitCode, clierrors.ExitCode(err))
+			}
+		})
+	}
+
+	_, err := Load(filepath.Join(t.TempDir(), "missing.yaml"), nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to read manifests")
+		assert.Equal(t, clierrors.ExitError, clierrors.ExitCode(err))
+	}
+}
@@ -0,0 +1,111 @@
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+)
+
+// ActionType is the change needed to reconcile an application
+type ActionType string
+
+const (
+	// ActionCreate creates an application that does not exist yet
+	ActionCreate ActionType = "create"
+	// ActionUpdate updates an application whose live state differs from its manifest
+	ActionUpdate ActionType = "update"
+	// ActionNone leaves an application that already matches its manifest unchanged
+	ActionNone ActionType = "unchanged"
+	// ActionDelete deletes an application that has no manifest (only when pruning)
+	ActionDelete ActionType = "delete"
+)
+
+// Action is a planned change to a single application
+type Action struct {
+	Type ActionType
+	ID   string
+	// Desired is the manifest of the application, nil for deletions
+	Desired *AppManifest
+	// Live is the current state of the application, nil for creations
+	Live *humanitec.App
+}
+
+// Plan compares the manifests with the live applications and returns the
+// actions needed to reconcile them, in manifest order. When prune is true,
+// applications that exist in the organization but have no manifest are
+// planned for deletion after all other actions.
+func Plan(client humanitec.Client, manifests []AppManifest, prune bool) ([]Action, error) {
+	var actions []Action
+	declared := map[string]bool{}
+
+	for i := range manifests {
+		desired := &manifests[i]
+		declared[desired.ID] = true
+
+		live, err := client.GetApp(desired.ID)
+		if humanitec.IsNotFound(err) {
+			actions = append(actions, Action{Type: ActionCreate, ID: desired.ID, Desired: desired})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get app %s: %w", desired.ID, err)
+		}
+
+		actionType := ActionNone
+		if live.Name != desired.Name {
+			actionType = ActionUpdate
+		}
+		actions = append(actions, Action{Type: actionType, ID: desired.ID, Desired: desired, Live: live})
+	}
+
+	if prune {
+		apps, err := client.GetApps()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list apps: %w", err)
+		}
+		for i := range apps {
+			if !declared[apps[i].ID] {
+				actions = append(actions, Action{Type: ActionDelete, ID: apps[i].ID, Live: &apps[i]})
+			}
+		}
+	}
+
+	return actions, nil
+}
+
+// FromApp returns the manifest describing the live state of an application
+func FromApp(app *humanitec.App) AppManifest {
+	return AppManifest{Kind: KindApp, ID: app.ID, Name: app.Name}
+}
+
+// Request returns the API request a planned action sends, and false for actions that send none
+func Request(org string, action Action) (humanitec.Request, bool) {
+	switch action.Type {
+	case ActionCreate:
+		return humanitec.CreateAppRequest(org, action.ID, action.Desired.Name, false), true
+	case ActionUpdate:
+		return humanitec.UpdateAppRequest(org, action.ID, action.Desired.Name), true
+	case ActionDelete:
+		return humanitec.DeleteAppRequest(org, action.ID), true
+	default:
+		return humanitec.Request{}, false
+	}
+}
+
+// Execute performs a single planned action
+func Execute(client humanitec.Client, action Action) error {
+	switch action.Type {
+	case ActionCreate:
+		_, err := client.CreateApp(action.ID, action.Desired.Name, false)
+		return err
+	case ActionUpdate:
+		_, err := client.UpdateApp(action.ID, action.Desired.Name)
+		return err
+	case ActionDelete:
+		return client.DeleteApp(action.ID)
+	case ActionNone:
+		return nil
+	default:
+		return fmt.Errorf("unknown action: %s", action.Type)
+	}
+}
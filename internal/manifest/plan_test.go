@@ -0,0 +1,78 @@
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPlan verifies that missing apps are created, changed apps are updated, matching apps
+// are left unchanged and, when pruning, undeclared apps are deleted after all other actions
+func TestPlan(t *testing.T) {
+	manifests := []AppManifest{
+		{Kind: KindApp, ID: "test-app", Name: "Test App"},
+		{Kind: KindApp, ID: "renamed-app", Name: "New Name"},
+		{Kind: KindApp, ID: "new-app", Name: "New App"},
+	}
+	testApp := humanitec.App{ID: "test-app", Name: "Test App"}
+	renamedApp := humanitec.App{ID: "renamed-app", Name: "Old Name"}
+	oldApp := humanitec.App{ID: "old-app", Name: "Old App"}
+
+	testCases := []struct {
+		name     string
+		prune    bool
+		expected []Action
+	}{
+		{
+			name: "create, update and unchanged",
+			expected: []Action{
+				{Type: ActionNone, ID: "test-app", Desired: &manifests[0], Live: &testApp},
+				{Type: ActionUpdate, ID: "renamed-app", Desired: &manifests[1], Live: &renamedApp},
+				{Type: ActionCreate, ID: "new-app", Desired: &manifests[2]},
+			},
+		},
+		{
+			name:  "prune",
+			prune: true,
+			expected: []Action{
+				{Type: ActionNone, ID: "test-app", Desired: &manifests[0], Live: &testApp},
+				{Type: ActionUpdate, ID: "renamed-app", Desired: &manifests[1], Live: &renamedApp},
+				{Type: ActionCreate, ID: "new-app", Desired: &manifests[2]},
+				{Type: ActionDelete, ID: "old-app", Live: &oldApp},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := &test.MockClient{
+				Apps: []humanitec.App{testApp, renamedApp, oldApp},
+				AppsByID: map[string]*humanitec.App{
+					"test-app":    &testApp,
+					"renamed-app": &renamedApp,
+				},
+				AppErrors: map[string]error{"new-app": &humanitec.APIError{StatusCode: 404}},
+			}
+
+			actions, err := Plan(mockClient, manifests, tc.prune)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actions)
+		})
+	}
+}
+
+// TestPlanErrors verifies that errors other than a missing app stop the plan
+func TestPlanErrors(t *testing.T) {
+	manifests := []AppManifest{{Kind: KindApp, ID: "test-app", Name: "Test App"}}
+
+	mockClient := &test.MockClient{AppErrors: map[string]error{"test-app": errors.New("connection refused")}}
+	_, err := Plan(mockClient, manifests, false)
+	assert.EqualError(t, err, "failed to get app test-app: connection refused")
+
+	mockClient = &test.MockClient{Error: errors.New("connection refused")}
+	_, err = Plan(mockClient, nil, true)
+	assert.EqualError(t, err, "failed to list apps: connection refused")
+}
@@ -0,0 +1,116 @@
+package output
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+)
+
+// ColorMode controls when human-readable output is colorized
+type ColorMode string
+
+const (
+	// ColorAuto colorizes output only when writing to a terminal
+	ColorAuto ColorMode = "auto"
+	// ColorAlways always colorizes output
+	ColorAlways ColorMode = "always"
+	// ColorNever never colorizes output
+	ColorNever ColorMode = "never"
+)
+
+// ANSI escape sequences used for styling
+const (
+	ansiReset = "\x1b[0m"
+	ansiBold  = "\x1b[1m"
+	ansiRed   = "\x1b[31m"
+	ansiGreen = "\x1b[32m"
+)
+
+var (
+	// colorMode is the configured color mode. Output is plain until the
+	// root command configures it, which keeps library and test output stable.
+	colorMode = ColorNever
+	// colorEnabled reports whether styling is applied to formatted output
+	colorEnabled bool
+)
+
+// ParseColorMode validates a --color flag value
+func ParseColorMode(mode string) (ColorMode, error) {
+	switch ColorMode(strings.ToLower(mode)) {
+	case ColorAuto, ColorAlways, ColorNever:
+		return ColorMode(strings.ToLower(mode)), nil
+	default:
+		return ColorNever, clierrors.Validationf("unsupported color mode: %s. Supported modes: auto, always, never", mode)
+	}
+}
+
+// ConfigureColor sets the color mode and decides whether formatted output,
+// which is written to w, is colorized
+func ConfigureColor(mode ColorMode, w io.Writer) {
+	colorMode = mode
+	colorEnabled = ShouldColor(w)
+}
+
+// ShouldColor reports whether output written to w should be colorized under
+// the configured mode. A non-empty NO_COLOR environment variable disables
+// automatic coloring (see https://no-color.org).
+func ShouldColor(w io.Writer) bool {
+	switch colorMode {
+	case ColorAlways:
+		return true
+	case ColorAuto:
+		if os.Getenv("NO_COLOR") != "" {
+			return false
+		}
+		return IsTerminal(w)
+	default:
+		return false
+	}
+}
+
+// IsTerminal reports whether v, typically a reader or writer, is a terminal.
+// The null device is a character device too but never a terminal.
+func IsTerminal(v interface{}) bool {
+	f, ok := v.(*os.File)
+	if !ok || f.Name() == os.DevNull {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// ErrorText styles an error message for w
+func ErrorText(w io.Writer, s string) string {
+	if !ShouldColor(w) {
+		return s
+	}
+	return ansiRed + s + ansiReset
+}
+
+// header styles a table header
+func header(s string) string {
+	return style(ansiBold, s)
+}
+
+// success styles a success message
+func success(s string) string {
+	return style(ansiGreen, s)
+}
+
+// failure styles a failure message
+func failure(s string) string {
+	return style(ansiRed, s)
+}
+
+// style wraps s in the given escape sequence when color is enabled
+func style(code, s string) string {
+	if !colorEnabled || s == "" {
+		return s
+	}
+	return code + s + ansiReset
+}
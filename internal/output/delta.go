@@ -0,0 +1,200 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"gopkg.in/yaml.v3"
+)
+
+// Envelope kinds of deltas
+const (
+	KindDelta     = "Delta"
+	KindDeltaList = "DeltaList"
+)
+
+// FormatDeltas formats a list of deltas in the specified format.
+// Table formats list the workloads each delta adds, updates and removes.
+func FormatDeltas(deltas []humanitec.Delta, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		var sb strings.Builder
+		for _, delta := range deltas {
+			line, err := marshal(delta, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
+		}
+		return sb.String(), nil
+
+	case FormatJSON, FormatYAML:
+		if deltas == nil {
+			deltas = []humanitec.Delta{}
+		}
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindDeltaList, Items: deltas}, format)
+
+	case FormatTable:
+		var sb strings.Builder
+		sb.WriteString(header("ID\tNAME\tENV\tADDED\tUPDATED\tREMOVED") + "\n")
+		sb.WriteString("--\t----\t---\t-----\t-------\t-------\n")
+		for _, d := range deltas {
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\n", d.ID, orNone(d.Metadata.Name), orNone(d.Metadata.EnvID),
+				workloadList(d.Modules.Add), workloadList(d.Modules.Update), orNone(strings.Join(d.Modules.Remove, ","))))
+		}
+		return sb.String(), nil
+
+	case FormatWide:
+		var sb strings.Builder
+		sb.WriteString(header("ID\tNAME\tENV\tADDED\tUPDATED\tREMOVED\tSHARED\tCREATED AT\tCREATED BY\tLAST MODIFIED AT\tARCHIVED") + "\n")
+		sb.WriteString("--\t----\t---\t-----\t-------\t-------\t------\t----------\t----------\t----------------\t--------\n")
+		for _, d := range deltas {
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\t%t\n", d.ID, orNone(d.Metadata.Name), orNone(d.Metadata.EnvID),
+				workloadList(d.Modules.Add), workloadList(d.Modules.Update), orNone(strings.Join(d.Modules.Remove, ",")), len(d.Shared),
+				orNone(d.Metadata.CreatedAt), orNone(d.Metadata.CreatedBy), orNone(d.Metadata.LastModifiedAt), d.Metadata.Archived))
+		}
+		return sb.String(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// FormatDelta formats a single delta in the specified format
+func FormatDelta(delta *humanitec.Delta, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		return marshal(delta, format)
+
+	case FormatJSON, FormatYAML:
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindDelta, Item: delta}, format)
+
+	case FormatTable, FormatWide:
+		return FormatDeltas([]humanitec.Delta{*delta}, format)
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// FormatDeltaDescription formats a delta in the specified format.
+// Table and wide formats render a human-readable summary with the added workloads
+// and the patch operations of updated workloads and shared resources.
+func FormatDeltaDescription(delta *humanitec.Delta, format Format) (string, error) {
+	if format != FormatTable && format != FormatWide {
+		return FormatDelta(delta, format)
+	}
+
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "%s\t%s\n", header("ID:"), delta.ID)
+	fmt.Fprintf(w, "%s\t%s\n", header("Name:"), orNone(delta.Metadata.Name))
+	fmt.Fprintf(w, "%s\t%s\n", header("Environment:"), orNone(delta.Metadata.EnvID))
+	fmt.Fprintf(w, "%s\t%t\n", header("Archived:"), delta.Metadata.Archived)
+	fmt.Fprintf(w, "%s\t%s\n", header("Created At:"), orNone(delta.Metadata.CreatedAt))
+	fmt.Fprintf(w, "%s\t%s\n", header("Created By:"), orNone(delta.Metadata.CreatedBy))
+	fmt.Fprintf(w, "%s\t%s\n", header("Last Modified At:"), orNone(delta.Metadata.LastModifiedAt))
+	w.Flush()
+
+	sb.WriteString("\n" + header("Added workloads:") + "\n")
+	if len(delta.Modules.Add) == 0 {
+		sb.WriteString("  <none>\n")
+	}
+	for _, id := range sortedIDs(delta.Modules.Add) {
+		data, err := yaml.Marshal(delta.Modules.Add[id])
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal to YAML: %w", err)
+		}
+		sb.WriteString("  " + success("+ "+id) + "\n")
+		sb.WriteString(indent(string(data), "    "))
+	}
+
+	sb.WriteString("\n" + header("Updated workloads:") + "\n")
+	if len(delta.Modules.Update) == 0 {
+		sb.WriteString("  <none>\n")
+	}
+	for _, id := range sortedIDs(delta.Modules.Update) {
+		sb.WriteString("  ~ " + id + "\n")
+		ops, err := formatPatchOps(delta.Modules.Update[id], "    ")
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(ops)
+	}
+
+	sb.WriteString("\n" + header("Removed workloads:") + "\n")
+	if len(delta.Modules.Remove) == 0 {
+		sb.WriteString("  <none>\n")
+	}
+	for _, id := range delta.Modules.Remove {
+		sb.WriteString("  " + failure("- "+id) + "\n")
+	}
+
+	sb.WriteString("\n" + header("Shared resources:") + "\n")
+	if len(delta.Shared) == 0 {
+		sb.WriteString("  <none>\n")
+	}
+	ops, err := formatPatchOps(delta.Shared, "  ")
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(ops)
+
+	return sb.String(), nil
+}
+
+// formatPatchOps renders generic JSON Patch operations one per line as "op path value"
+func formatPatchOps(ops []interface{}, prefix string) (string, error) {
+	var sb strings.Builder
+	for _, op := range ops {
+		m, ok := op.(map[string]interface{})
+		if !ok {
+			data, err := json.Marshal(op)
+			if err != nil {
+				return "", fmt.Errorf("failed to marshal to JSON: %w", err)
+			}
+			sb.WriteString(prefix + string(data) + "\n")
+			continue
+		}
+		line := fmt.Sprintf("%s%v %v", prefix, m["op"], m["path"])
+		if value, ok := m["value"]; ok {
+			data, err := json.Marshal(value)
+			if err != nil {
+				return "", fmt.Errorf("failed to marshal to JSON: %w", err)
+			}
+			line += " " + string(data)
+		}
+		sb.WriteString(line + "\n")
+	}
+	return sb.String(), nil
+}
+
+// workloadList returns the sorted, comma-separated IDs of workloads, or "<none>"
+func workloadList[T any](workloads map[string]T) string {
+	return orNone(strings.Join(sortedIDs(workloads), ","))
+}
+
+// sortedIDs returns the keys of a map in lexical order
+func sortedIDs[T any](m map[string]T) []string {
+	ids := make([]string, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// indent prefixes every non-empty line of text
+func indent(text, prefix string) string {
+	lines := strings.SplitAfter(text, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "")
+}
@@ -0,0 +1,251 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+)
+
+// Envelope kinds of deployments
+const (
+	KindDeployment            = "Deployment"
+	KindDeploymentList        = "DeploymentList"
+	KindDeploymentDescription = "DeploymentDescription"
+	KindDeploymentErrorList   = "DeploymentErrorList"
+	KindDeploymentLogList     = "DeploymentLogList"
+)
+
+// DeploymentDescription combines a deployment with the errors it reported
+type DeploymentDescription struct {
+	Deployment *humanitec.Deployment       `json:"deployment" yaml:"deployment"`
+	Errors     []humanitec.DeploymentError `json:"errors" yaml:"errors"`
+}
+
+// FormatDeployments formats a list of deployments in the specified format.
+// NDJSON writes one deployment per line without an envelope.
+func FormatDeployments(deployments []humanitec.Deployment, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		var sb strings.Builder
+		for _, deployment := range deployments {
+			line, err := marshal(deployment, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
+		}
+		return sb.String(), nil
+
+	case FormatJSON, FormatYAML:
+		if deployments == nil {
+			deployments = []humanitec.Deployment{}
+		}
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindDeploymentList, Items: deployments}, format)
+
+	case FormatTable:
+		var sb strings.Builder
+		sb.WriteString(header("ID\tSTATUS\tSET\tCREATED AT\tCOMMENT") + "\n")
+		sb.WriteString("--\t------\t---\t----------\t-------\n")
+		for _, d := range deployments {
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%s\t%s\t%s\n", d.ID, deploymentStatus(d.Status), d.SetID, orNone(d.CreatedAt), d.Comment))
+		}
+		return sb.String(), nil
+
+	case FormatWide:
+		var sb strings.Builder
+		sb.WriteString(header("ID\tSTATUS\tSET\tDELTA\tFROM\tCREATED AT\tCREATED BY\tSTATUS CHANGED AT\tCOMMENT") + "\n")
+		sb.WriteString("--\t------\t---\t-----\t----\t----------\t----------\t-----------------\t-------\n")
+		for _, d := range deployments {
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", d.ID, deploymentStatus(d.Status), d.SetID, orNone(d.DeltaID), orNone(d.FromID),
+				orNone(d.CreatedAt), orNone(d.CreatedBy), orNone(d.StatusChangedAt), d.Comment))
+		}
+		return sb.String(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// FormatDeployment formats a single deployment in the specified format
+func FormatDeployment(deployment *humanitec.Deployment, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		return marshal(deployment, format)
+
+	case FormatJSON, FormatYAML:
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindDeployment, Item: deployment}, format)
+
+	case FormatTable, FormatWide:
+		return FormatDeployments([]humanitec.Deployment{*deployment}, format)
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// FormatDeploymentDescription formats a deployment with its errors in the specified format.
+// Table and wide formats render a human-readable multi-section summary.
+func FormatDeploymentDescription(d *DeploymentDescription, format Format) (string, error) {
+	switch format {
+	case FormatJSON, FormatYAML:
+		if d.Errors == nil {
+			d.Errors = []humanitec.DeploymentError{}
+		}
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindDeploymentDescription, Item: d}, format)
+
+	case FormatNDJSON:
+		return marshal(d, format)
+
+	case FormatTable, FormatWide:
+		var sb strings.Builder
+		w := tabwriter.NewWriter(&sb, 0, 4, 2, ' ', 0)
+
+		deployment := d.Deployment
+		fmt.Fprintf(w, "%s\t%s\n", header("ID:"), deployment.ID)
+		fmt.Fprintf(w, "%s\t%s\n", header("Environment:"), deployment.EnvID)
+		fmt.Fprintf(w, "%s\t%s\n", header("Status:"), deploymentStatus(deployment.Status))
+		fmt.Fprintf(w, "%s\t%s\n", header("Status Changed At:"), orNone(deployment.StatusChangedAt))
+		fmt.Fprintf(w, "%s\t%s\n", header("Set:"), deployment.SetID)
+		fmt.Fprintf(w, "%s\t%s\n", header("Delta:"), orNone(deployment.DeltaID))
+		fmt.Fprintf(w, "%s\t%s\n", header("From:"), orNone(deployment.FromID))
+		fmt.Fprintf(w, "%s\t%s\n", header("Created At:"), orNone(deployment.CreatedAt))
+		fmt.Fprintf(w, "%s\t%s\n", header("Created By:"), orNone(deployment.CreatedBy))
+		fmt.Fprintf(w, "%s\t%s\n", header("Comment:"), orNone(deployment.Comment))
+		w.Flush()
+
+		sb.WriteString("\n" + header("Errors:") + "\n")
+		sb.WriteString(formatDeploymentErrors(d.Errors))
+
+		return sb.String(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// FormatDeploymentErrors formats the errors of a deployment in the specified format.
+// Table output shows the summary of each error, wide output its summary and full message.
+func FormatDeploymentErrors(deploymentErrors []humanitec.DeploymentError, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		var sb strings.Builder
+		for _, e := range deploymentErrors {
+			line, err := marshal(e, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
+		}
+		return sb.String(), nil
+
+	case FormatJSON, FormatYAML:
+		if deploymentErrors == nil {
+			deploymentErrors = []humanitec.DeploymentError{}
+		}
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindDeploymentErrorList, Items: deploymentErrors}, format)
+
+	case FormatTable:
+		var sb strings.Builder
+		sb.WriteString(header("TIME\tSCOPE\tOBJECT\tCODE\tMESSAGE") + "\n")
+		sb.WriteString("----\t-----\t------\t----\t-------\n")
+		for _, e := range deploymentErrors {
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%s\t%s\t%s\n", orNone(e.CreatedAt), e.Scope, e.ObjectID, orNone(e.Code), errorSummary(e)))
+		}
+		return sb.String(), nil
+
+	case FormatWide:
+		var sb strings.Builder
+		sb.WriteString(header("TIME\tSCOPE\tOBJECT\tCODE\tSUMMARY\tMESSAGE") + "\n")
+		sb.WriteString("----\t-----\t------\t----\t-------\t-------\n")
+		for _, e := range deploymentErrors {
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\n", orNone(e.CreatedAt), e.Scope, e.ObjectID, orNone(e.Code), orNone(e.Summary), e.Message))
+		}
+		return sb.String(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// FormatDeploymentLogs formats the log lines of a deployment in the specified format.
+// Table formats print one line per entry with error lines highlighted.
+func FormatDeploymentLogs(logs []humanitec.DeploymentLog, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		var sb strings.Builder
+		for _, l := range logs {
+			line, err := marshal(l, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
+		}
+		return sb.String(), nil
+
+	case FormatJSON, FormatYAML:
+		if logs == nil {
+			logs = []humanitec.DeploymentLog{}
+		}
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindDeploymentLogList, Items: logs}, format)
+
+	case FormatTable, FormatWide:
+		var sb strings.Builder
+		sb.WriteString(header("TIMESTAMP\tSTEP\tLEVEL\tMESSAGE") + "\n")
+		sb.WriteString("---------\t----\t-----\t-------\n")
+		for _, l := range logs {
+			level := orNone(l.Level)
+			if l.Level == "error" {
+				level = failure(level)
+			}
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%s\t%s\n", l.Timestamp, l.Step, level, l.Message))
+		}
+		return sb.String(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// formatDeploymentErrors renders deployment errors as an indented table
+func formatDeploymentErrors(deploymentErrors []humanitec.DeploymentError) string {
+	if len(deploymentErrors) == 0 {
+		return "  <none>\n"
+	}
+
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "  TIME\tSCOPE\tOBJECT\tCODE\tMESSAGE")
+	for _, e := range deploymentErrors {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", orNone(e.CreatedAt), e.Scope, e.ObjectID, orNone(e.Code), errorSummary(e))
+	}
+	w.Flush()
+	return sb.String()
+}
+
+// errorSummary returns the summary of a deployment error, or its message if it has none
+func errorSummary(e humanitec.DeploymentError) string {
+	if e.Summary != "" {
+		return e.Summary
+	}
+	return e.Message
+}
+
+// FormatDeploymentErrorSummary renders the errors of a failed deployment for people,
+// as an indented table under a heading naming the deployment
+func FormatDeploymentErrorSummary(deploymentID string, deploymentErrors []humanitec.DeploymentError) string {
+	return failure(fmt.Sprintf("Deployment %s failed:", deploymentID)) + "\n" + formatDeploymentErrors(deploymentErrors)
+}
+
+// deploymentStatus colors a deployment status by its outcome
+func deploymentStatus(status string) string {
+	switch status {
+	case humanitec.DeploymentSucceeded:
+		return success(status)
+	case humanitec.DeploymentFailed:
+		return failure(status)
+	default:
+		return status
+	}
+}
@@ -0,0 +1,109 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+)
+
+// KindAppDescription is the envelope kind of an aggregated application view
+const KindAppDescription = "AppDescription"
+
+// Sections of an application description that may fail on their own, named after their fields
+const (
+	SectionEnvironments = "environments"
+	SectionSharedValues = "sharedValues"
+	SectionPipelines    = "pipelines"
+)
+
+// AppDescription aggregates an application with its environments, shared values and pipelines
+type AppDescription struct {
+	App          *humanitec.App          `json:"app" yaml:"app"`
+	Environments []humanitec.Environment `json:"environments" yaml:"environments"`
+	SharedValues int                     `json:"sharedValues" yaml:"sharedValues"`
+	Pipelines    []humanitec.Pipeline    `json:"pipelines" yaml:"pipelines"`
+	// Errors describes the sections that could not be retrieved, with the section as ID
+	Errors []ErrorDetail `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// sectionError returns the error of the given section, or nil if it was retrieved
+func (d *AppDescription) sectionError(section string) *ErrorDetail {
+	for i := range d.Errors {
+		if d.Errors[i].ID == section {
+			return &d.Errors[i]
+		}
+	}
+	return nil
+}
+
+// FormatAppDescription formats an aggregated application view in the specified format.
+// Table and wide formats render a human-readable multi-section summary, in which
+// sections that could not be retrieved are marked as unavailable.
+func FormatAppDescription(d *AppDescription, format Format) (string, error) {
+	switch format {
+	case FormatJSON, FormatYAML:
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindAppDescription, Item: d}, format)
+
+	case FormatNDJSON:
+		return marshal(d, format)
+
+	case FormatTable, FormatWide:
+		var sb strings.Builder
+		w := tabwriter.NewWriter(&sb, 0, 4, 2, ' ', 0)
+
+		fmt.Fprintf(w, "%s\t%s\n", header("Name:"), d.App.Name)
+		fmt.Fprintf(w, "%s\t%s\n", header("ID:"), d.App.ID)
+		fmt.Fprintf(w, "%s\t%s\n", header("Created At:"), orNone(d.App.CreatedAt))
+		fmt.Fprintf(w, "%s\t%s\n", header("Created By:"), orNone(d.App.CreatedBy))
+		if d.sectionError(SectionSharedValues) != nil {
+			fmt.Fprintf(w, "%s\t%s\n", header("Shared Values:"), unavailable())
+		} else {
+			fmt.Fprintf(w, "%s\t%d\n", header("Shared Values:"), d.SharedValues)
+		}
+		w.Flush()
+
+		sb.WriteString("\n" + header("Environments:") + "\n")
+		if d.sectionError(SectionEnvironments) != nil {
+			sb.WriteString("  " + unavailable() + "\n")
+		} else if len(d.Environments) == 0 {
+			sb.WriteString("  <none>\n")
+		} else {
+			w = tabwriter.NewWriter(&sb, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "  ID\tNAME\tTYPE\tLAST DEPLOYMENT\tSTATUS\tDEPLOYED AT")
+			for _, env := range d.Environments {
+				deployID, status, deployedAt := "<none>", "<none>", "<none>"
+				if env.LastDeploy != nil {
+					deployID, status, deployedAt = env.LastDeploy.ID, env.LastDeploy.Status, orNone(env.LastDeploy.CreatedAt)
+				}
+				fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\t%s\n", env.ID, env.Name, env.Type, deployID, status, deployedAt)
+			}
+			w.Flush()
+		}
+
+		sb.WriteString("\n" + header("Pipelines:") + "\n")
+		if d.sectionError(SectionPipelines) != nil {
+			sb.WriteString("  " + unavailable() + "\n")
+		} else if len(d.Pipelines) == 0 {
+			sb.WriteString("  <none>\n")
+		} else {
+			w = tabwriter.NewWriter(&sb, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "  ID\tNAME\tSTATUS")
+			for _, pipeline := range d.Pipelines {
+				fmt.Fprintf(w, "  %s\t%s\t%s\n", pipeline.ID, pipeline.Name, pipeline.Status)
+			}
+			w.Flush()
+		}
+
+		return sb.String(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// unavailable marks a section of a description that could not be retrieved
+func unavailable() string {
+	return failure("<unavailable>")
+}
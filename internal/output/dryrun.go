@@ -0,0 +1,52 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+)
+
+// KindDryRun is the envelope kind of the requests a dry run would send
+const KindDryRun = "DryRun"
+
+// FormatRequests formats the API requests that a dry run would have sent.
+// Table output prints each request line followed by its indented JSON body.
+func FormatRequests(requests []humanitec.Request, format Format) (string, error) {
+	switch format {
+	case FormatJSON, FormatYAML:
+		if requests == nil {
+			requests = []humanitec.Request{}
+		}
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindDryRun, Items: requests}, format)
+
+	case FormatNDJSON:
+		var sb strings.Builder
+		for _, req := range requests {
+			line, err := marshal(req, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
+		}
+		return sb.String(), nil
+
+	case FormatTable, FormatWide:
+		var sb strings.Builder
+		for _, req := range requests {
+			sb.WriteString(fmt.Sprintf("%s %s %s\n", header("DRY RUN:"), req.Method, req.Path))
+			if req.Body != nil {
+				data, err := json.MarshalIndent(req.Body, "", "  ")
+				if err != nil {
+					return "", fmt.Errorf("failed to marshal to JSON: %w", err)
+				}
+				sb.WriteString(string(data) + "\n")
+			}
+		}
+		return sb.String(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
@@ -0,0 +1,137 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+)
+
+// Envelope kinds of environments
+const (
+	KindEnvironment         = "Environment"
+	KindEnvironmentList     = "EnvironmentList"
+	KindEnvironmentType     = "EnvironmentType"
+	KindEnvironmentTypeList = "EnvironmentTypeList"
+)
+
+// FormatEnvs formats a list of environments in the specified format.
+// NDJSON writes one environment per line without an envelope.
+func FormatEnvs(envs []humanitec.Environment, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		var sb strings.Builder
+		for _, env := range envs {
+			line, err := marshal(env, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
+		}
+		return sb.String(), nil
+
+	case FormatJSON, FormatYAML:
+		if envs == nil {
+			envs = []humanitec.Environment{}
+		}
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindEnvironmentList, Items: envs}, format)
+
+	case FormatTable:
+		var sb strings.Builder
+		sb.WriteString(header("ID\tNAME\tTYPE") + "\n")
+		sb.WriteString("--\t----\t----\n")
+		for _, env := range envs {
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%s\n", env.ID, env.Name, env.Type))
+		}
+		return sb.String(), nil
+
+	case FormatWide:
+		return formatEnvsWide(envs), nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// FormatEnv formats a single environment in the specified format
+func FormatEnv(env *humanitec.Environment, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		return marshal(env, format)
+
+	case FormatJSON, FormatYAML:
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindEnvironment, Item: env}, format)
+
+	case FormatTable, FormatWide:
+		return FormatEnvs([]humanitec.Environment{*env}, format)
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// formatEnvsWide formats environments as a table including creation details and the last deployment
+func formatEnvsWide(envs []humanitec.Environment) string {
+	var sb strings.Builder
+	sb.WriteString(header("ID\tNAME\tTYPE\tCREATED AT\tCREATED BY\tLAST DEPLOYMENT\tSTATUS") + "\n")
+	sb.WriteString("--\t----\t----\t----------\t----------\t---------------\t------\n")
+	for _, env := range envs {
+		deployID, status := "<none>", "<none>"
+		if env.LastDeploy != nil {
+			deployID, status = env.LastDeploy.ID, orNone(env.LastDeploy.Status)
+		}
+		sb.WriteString(fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s\n", env.ID, env.Name, env.Type, orNone(env.CreatedAt), orNone(env.CreatedBy), deployID, status))
+	}
+	return sb.String()
+}
+
+// FormatEnvTypes formats a list of environment types in the specified format
+func FormatEnvTypes(envTypes []humanitec.EnvironmentType, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		var sb strings.Builder
+		for _, envType := range envTypes {
+			line, err := marshal(envType, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
+		}
+		return sb.String(), nil
+
+	case FormatJSON, FormatYAML:
+		if envTypes == nil {
+			envTypes = []humanitec.EnvironmentType{}
+		}
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindEnvironmentTypeList, Items: envTypes}, format)
+
+	case FormatTable, FormatWide:
+		var sb strings.Builder
+		sb.WriteString(header("ID\tDESCRIPTION") + "\n")
+		sb.WriteString("--\t-----------\n")
+		for _, envType := range envTypes {
+			sb.WriteString(fmt.Sprintf("%s\t%s\n", envType.ID, orNone(envType.Description)))
+		}
+		return sb.String(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// FormatEnvType formats a single environment type in the specified format
+func FormatEnvType(envType *humanitec.EnvironmentType, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		return marshal(envType, format)
+
+	case FormatJSON, FormatYAML:
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindEnvironmentType, Item: envType}, format)
+
+	case FormatTable, FormatWide:
+		return FormatEnvTypes([]humanitec.EnvironmentType{*envType}, format)
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
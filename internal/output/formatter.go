@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
 	"gopkg.in/yaml.v3"
 )
@@ -15,81 +16,157 @@ type Format string
 const (
 	// FormatTable represents table output format
 	FormatTable Format = "table"
+	// FormatWide represents table output format with additional columns
+	FormatWide Format = "wide"
 	// FormatJSON represents JSON output format
 	FormatJSON Format = "json"
 	// FormatYAML represents YAML output format
 	FormatYAML Format = "yaml"
+	// FormatNDJSON represents newline-delimited JSON output format
+	FormatNDJSON Format = "ndjson"
 )
 
+// APIVersion is the version of the machine-readable output envelope
+const APIVersion = "humctl-wrapper/v1"
+
+// Envelope kinds
+const (
+	KindApp     = "App"
+	KindAppList = "AppList"
+	KindMessage = "Message"
+	KindError   = "Error"
+)
+
+// Envelope wraps every machine-readable (JSON or YAML) output so that
+// automation can rely on a stable document shape
+type Envelope struct {
+	APIVersion string       `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string       `json:"kind" yaml:"kind"`
+	Items      interface{}  `json:"items,omitempty" yaml:"items,omitempty"`
+	Item       interface{}  `json:"item,omitempty" yaml:"item,omitempty"`
+	Error      *ErrorDetail `json:"error,omitempty" yaml:"error,omitempty"`
+	// Errors describes the items of a list that could not be retrieved
+	Errors []ErrorDetail `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// ErrorDetail describes a failed command, or the failure of a single item, in the output envelope
+type ErrorDetail struct {
+	// ID is the ID of the item that failed, if the failure is limited to one item
+	ID      string `json:"id,omitempty" yaml:"id,omitempty"`
+	Code    string `json:"code" yaml:"code"`
+	Message string `json:"message" yaml:"message"`
+	Status  int    `json:"status,omitempty" yaml:"status,omitempty"`
+}
+
 // ValidateFormat validates if the given format is supported
 func ValidateFormat(format string) (Format, error) {
 	switch Format(strings.ToLower(format)) {
-	case FormatTable, FormatJSON, FormatYAML:
+	case FormatTable, FormatWide, FormatJSON, FormatYAML, FormatNDJSON:
 		return Format(strings.ToLower(format)), nil
 	default:
-		return FormatTable, fmt.Errorf("unsupported output format: %s. Supported formats: table, json, yaml", format)
+		return FormatTable, clierrors.Validationf("unsupported output format: %s. Supported formats: table, wide, json, yaml, ndjson", format)
 	}
 }
 
+// IsMachineFormat reports whether the format is meant to be parsed by other programs
+func IsMachineFormat(format Format) bool {
+	return format == FormatJSON || format == FormatYAML || format == FormatNDJSON
+}
+
 // FormatApps formats a list of applications in the specified format.
 // JSON and Table formats include a trailing newline, while YAML format
-// uses the newline provided by the YAML marshaler.
+// uses the newline provided by the YAML marshaler. NDJSON writes one
+// application per line without an envelope.
 func FormatApps(apps []humanitec.App, format Format) (string, error) {
 	switch format {
-	case FormatJSON:
-		data, err := json.MarshalIndent(apps, "", "  ")
-		if err != nil {
-			return "", fmt.Errorf("failed to marshal to JSON: %w", err)
+	case FormatNDJSON:
+		var sb strings.Builder
+		for _, app := range apps {
+			line, err := marshal(app, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
 		}
-		return string(data) + "\n", nil
+		return sb.String(), nil
 
-	case FormatYAML:
-		data, err := yaml.Marshal(apps)
-		if err != nil {
-			return "", fmt.Errorf("failed to marshal to YAML: %w", err)
+	case FormatJSON, FormatYAML:
+		if apps == nil {
+			apps = []humanitec.App{}
 		}
-		return string(data), nil
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindAppList, Items: apps}, format)
 
 	case FormatTable:
 		var sb strings.Builder
-		sb.WriteString("NAME\tID\n")
+		sb.WriteString(header("NAME\tID") + "\n")
 		sb.WriteString("----\t--\n")
 		for _, app := range apps {
 			sb.WriteString(fmt.Sprintf("%s\t%s\n", app.Name, app.ID))
 		}
 		return sb.String(), nil
 
+	case FormatWide:
+		return formatAppsWide(apps), nil
+
 	default:
 		return "", fmt.Errorf("unsupported format: %s", format)
 	}
 }
 
+// FormatAppLookup formats the applications found by looking up several IDs together with
+// the lookups that failed. JSON and YAML list the failures under "errors" of the AppList
+// envelope and NDJSON writes an error envelope per failure after the applications. Table
+// formats only list the applications that were found.
+func FormatAppLookup(apps []humanitec.App, failures []ErrorDetail, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		formatted, err := FormatApps(apps, format)
+		if err != nil {
+			return "", err
+		}
+		var sb strings.Builder
+		sb.WriteString(formatted)
+		for i := range failures {
+			line, err := marshal(Envelope{APIVersion: APIVersion, Kind: KindError, Error: &failures[i]}, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
+		}
+		return sb.String(), nil
+
+	case FormatJSON, FormatYAML:
+		if apps == nil {
+			apps = []humanitec.App{}
+		}
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindAppList, Items: apps, Errors: failures}, format)
+
+	default:
+		return FormatApps(apps, format)
+	}
+}
+
 // FormatApp formats a single application in the specified format.
 // JSON and Table formats include a trailing newline, while YAML format
 // uses the newline provided by the YAML marshaler.
 func FormatApp(app *humanitec.App, format Format) (string, error) {
 	switch format {
-	case FormatJSON:
-		data, err := json.MarshalIndent(app, "", "  ")
-		if err != nil {
-			return "", fmt.Errorf("failed to marshal to JSON: %w", err)
-		}
-		return string(data) + "\n", nil
+	case FormatNDJSON:
+		return marshal(app, format)
 
-	case FormatYAML:
-		data, err := yaml.Marshal(app)
-		if err != nil {
-			return "", fmt.Errorf("failed to marshal to YAML: %w", err)
-		}
-		return string(data), nil
+	case FormatJSON, FormatYAML:
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindApp, Item: app}, format)
 
 	case FormatTable:
 		var sb strings.Builder
-		sb.WriteString("NAME\tID\n")
+		sb.WriteString(header("NAME\tID") + "\n")
 		sb.WriteString("----\t--\n")
 		sb.WriteString(fmt.Sprintf("%s\t%s\n", app.Name, app.ID))
 		return sb.String(), nil
 
+	case FormatWide:
+		return formatAppsWide([]humanitec.App{*app}), nil
+
 	default:
 		return "", fmt.Errorf("unsupported format: %s", format)
 	}
@@ -99,26 +176,99 @@ func FormatApp(app *humanitec.App, format Format) (string, error) {
 // JSON and Table formats include a trailing newline, while YAML format
 // uses the newline provided by the YAML marshaler.
 func FormatMessage(message string, format Format) (string, error) {
+	return formatMessage(message, format, false)
+}
+
+// FormatSuccess formats a message reporting that an operation succeeded like FormatMessage,
+// but highlights it as a success in table formats
+func FormatSuccess(message string, format Format) (string, error) {
+	return formatMessage(message, format, true)
+}
+
+// formatMessage formats a message, styled as a success in table formats if succeeded is set
+func formatMessage(message string, format Format, succeeded bool) (string, error) {
 	switch format {
+	case FormatJSON, FormatYAML, FormatNDJSON:
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindMessage, Item: map[string]string{"message": message}}, format)
+
+	case FormatTable, FormatWide:
+		if succeeded {
+			message = success(message)
+		}
+		return message + "\n", nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// formatAppsWide formats applications as a table including creation details and environments
+func formatAppsWide(apps []humanitec.App) string {
+	var sb strings.Builder
+	sb.WriteString(header("NAME\tID\tCREATED AT\tCREATED BY\tENVS") + "\n")
+	sb.WriteString("----\t--\t----------\t----------\t----\n")
+	for _, app := range apps {
+		envs := make([]string, 0, len(app.Envs))
+		for _, env := range app.Envs {
+			envs = append(envs, fmt.Sprintf("%s(%s)", env.ID, env.Type))
+		}
+		sb.WriteString(fmt.Sprintf("%s\t%s\t%s\t%s\t%s\n", app.Name, app.ID, orNone(app.CreatedAt), orNone(app.CreatedBy), orNone(strings.Join(envs, ","))))
+	}
+	return sb.String()
+}
+
+// orNone returns s, or "<none>" if s is empty
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
+
+// FormatError formats a command error as an error envelope.
+// Only machine formats are supported; table output reports errors as plain text.
+func FormatError(err error, format Format) (string, error) {
+	if !IsMachineFormat(format) {
+		return "", fmt.Errorf("unsupported format for errors: %s", format)
+	}
+
+	detail := NewErrorDetail("", err)
+	return marshal(Envelope{APIVersion: APIVersion, Kind: KindError, Error: &detail}, format)
+}
+
+// NewErrorDetail describes err, the failure of the item with the given ID or of the whole
+// command if id is empty
+func NewErrorDetail(id string, err error) ErrorDetail {
+	class := clierrors.Classify(err)
+	return ErrorDetail{ID: id, Code: class.Code, Message: err.Error(), Status: class.Status}
+}
+
+// marshal encodes v as JSON, YAML or a single NDJSON line. JSON output gets
+// a trailing newline, while YAML relies on the newline provided by the YAML marshaler.
+func marshal(v interface{}, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal to JSON: %w", err)
+		}
+		return string(data) + "\n", nil
+
 	case FormatJSON:
-		data, err := json.MarshalIndent(map[string]string{"message": message}, "", "  ")
+		data, err := json.MarshalIndent(v, "", "  ")
 		if err != nil {
 			return "", fmt.Errorf("failed to marshal to JSON: %w", err)
 		}
 		return string(data) + "\n", nil
 
 	case FormatYAML:
-		data, err := yaml.Marshal(map[string]string{"message": message})
+		data, err := yaml.Marshal(v)
 		if err != nil {
 			return "", fmt.Errorf("failed to marshal to YAML: %w", err)
 		}
-		// YAML marshaling adds a newline, so we don't need to add another one
 		return string(data), nil
 
-	case FormatTable:
-		return message + "\n", nil
-
 	default:
 		return "", fmt.Errorf("unsupported format: %s", format)
 	}
-} 
\ No newline at end of file
+}
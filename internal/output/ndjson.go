@@ -0,0 +1,26 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// NDJSONWriter streams items as newline-delimited JSON, one compact object per line.
+// Each item is written as soon as it is received, so large lists are never buffered.
+type NDJSONWriter struct {
+	encoder *json.Encoder
+}
+
+// NewNDJSONWriter creates an NDJSONWriter that writes to w
+func NewNDJSONWriter(w io.Writer) *NDJSONWriter {
+	return &NDJSONWriter{encoder: json.NewEncoder(w)}
+}
+
+// Write encodes v as a single line
+func (w *NDJSONWriter) Write(v interface{}) error {
+	if err := w.encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to marshal to JSON: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/diffutil"
+)
+
+// KindJSONPatch is the envelope kind of a list of JSON Patch operations
+const KindJSONPatch = "JSONPatch"
+
+// FormatPatch formats JSON Patch operations in the specified format.
+// Table output lists one operation per line.
+func FormatPatch(ops []diffutil.PatchOp, format Format) (string, error) {
+	switch format {
+	case FormatJSON, FormatYAML:
+		if ops == nil {
+			ops = []diffutil.PatchOp{}
+		}
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindJSONPatch, Items: ops}, format)
+
+	case FormatNDJSON:
+		var sb strings.Builder
+		for _, op := range ops {
+			line, err := marshal(op, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
+		}
+		return sb.String(), nil
+
+	case FormatTable, FormatWide:
+		var sb strings.Builder
+		for _, op := range ops {
+			if op.Op == diffutil.OpRemove {
+				sb.WriteString(fmt.Sprintf("%s\t%s\n", failure(op.Op), op.Path))
+				continue
+			}
+			value, err := json.Marshal(op.Value)
+			if err != nil {
+				return "", fmt.Errorf("failed to marshal to JSON: %w", err)
+			}
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%s\n", success(op.Op), op.Path, value))
+		}
+		return sb.String(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// FormatUnifiedDiff colorizes a unified diff: additions are green and removals red
+func FormatUnifiedDiff(diff string) string {
+	if !colorEnabled {
+		return diff
+	}
+	lines := strings.SplitAfter(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			lines[i] = header(strings.TrimSuffix(line, "\n")) + "\n"
+		case strings.HasPrefix(line, "+"):
+			lines[i] = success(strings.TrimSuffix(line, "\n")) + "\n"
+		case strings.HasPrefix(line, "-"):
+			lines[i] = failure(strings.TrimSuffix(line, "\n")) + "\n"
+		}
+	}
+	return strings.Join(lines, "")
+}
@@ -0,0 +1,67 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KindResultList is the envelope kind of a per-item operation summary
+const KindResultList = "ResultList"
+
+// Result statuses
+const (
+	ResultSucceeded = "succeeded"
+	ResultFailed    = "failed"
+	ResultSkipped   = "skipped"
+	ResultPaused    = "paused"
+	ResultResumed   = "resumed"
+	// ResultAlreadyPaused and ResultAlreadyRunning report environments
+	// that were already in the requested state
+	ResultAlreadyPaused  = "already paused"
+	ResultAlreadyRunning = "already running"
+)
+
+// Result is the outcome of an operation on a single resource
+type Result struct {
+	ID     string `json:"id" yaml:"id"`
+	Status string `json:"status" yaml:"status"`
+	Error  string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// FormatResults formats a per-item summary of an operation that targeted several resources
+func FormatResults(results []Result, format Format) (string, error) {
+	switch format {
+	case FormatJSON, FormatYAML:
+		if results == nil {
+			results = []Result{}
+		}
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindResultList, Items: results}, format)
+
+	case FormatNDJSON:
+		var sb strings.Builder
+		for _, result := range results {
+			line, err := marshal(result, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
+		}
+		return sb.String(), nil
+
+	case FormatTable, FormatWide:
+		var sb strings.Builder
+		sb.WriteString(header("ID\tSTATUS\tERROR") + "\n")
+		sb.WriteString("--\t------\t-----\n")
+		for _, result := range results {
+			status := success(result.Status)
+			if result.Status == ResultFailed {
+				status = failure(result.Status)
+			}
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%s\n", result.ID, status, result.Error))
+		}
+		return sb.String(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
@@ -0,0 +1,98 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+)
+
+// Envelope kinds of environment runtimes
+const (
+	KindEnvironmentRuntime  = "EnvironmentRuntime"
+	KindWorkloadRuntimeList = "WorkloadRuntimeList"
+)
+
+// Workload runtime statuses that are colored by their outcome
+const (
+	workloadSuccess = "Success"
+	workloadFailure = "Failure"
+)
+
+// FormatEnvRuntime formats the runtime of an environment in the specified format.
+// JSON and YAML wrap the whole runtime in an envelope, NDJSON writes one workload per line
+// and table formats list the workloads.
+func FormatEnvRuntime(runtime *humanitec.EnvRuntime, format Format) (string, error) {
+	switch format {
+	case FormatJSON, FormatYAML:
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindEnvironmentRuntime, Item: runtime}, format)
+
+	case FormatNDJSON, FormatTable, FormatWide:
+		return FormatWorkloadRuntimes(runtime.Workloads(), format)
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// FormatWorkloadRuntimes formats the runtime state of workloads in the specified format.
+// Table formats show how many pods of each workload are ready and how often their
+// containers restarted; wide output also lists the pods with their phase.
+func FormatWorkloadRuntimes(workloads []humanitec.WorkloadRuntime, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		var sb strings.Builder
+		for _, workload := range workloads {
+			line, err := marshal(workload, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
+		}
+		return sb.String(), nil
+
+	case FormatJSON, FormatYAML:
+		if workloads == nil {
+			workloads = []humanitec.WorkloadRuntime{}
+		}
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindWorkloadRuntimeList, Items: workloads}, format)
+
+	case FormatTable:
+		var sb strings.Builder
+		sb.WriteString(header("WORKLOAD\tKIND\tREADY\tRESTARTS\tSTATUS") + "\n")
+		sb.WriteString("--------\t----\t-----\t--------\t------\n")
+		for _, w := range workloads {
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%d/%d\t%d\t%s\n", w.ID, orNone(w.Kind), w.ReadyPods(), w.Replicas, w.Restarts(), workloadStatus(w.Status)))
+		}
+		return sb.String(), nil
+
+	case FormatWide:
+		var sb strings.Builder
+		sb.WriteString(header("WORKLOAD\tKIND\tREADY\tRESTARTS\tSTATUS\tPODS") + "\n")
+		sb.WriteString("--------\t----\t-----\t--------\t------\t----\n")
+		for _, w := range workloads {
+			pods := make([]string, 0, len(w.Pods))
+			for _, pod := range w.Pods {
+				pods = append(pods, fmt.Sprintf("%s (%s)", pod.Name, pod.Phase))
+			}
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%d/%d\t%d\t%s\t%s\n", w.ID, orNone(w.Kind), w.ReadyPods(), w.Replicas, w.Restarts(),
+				workloadStatus(w.Status), orNone(strings.Join(pods, ","))))
+		}
+		return sb.String(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// workloadStatus colors a workload status by its outcome
+func workloadStatus(status string) string {
+	switch status {
+	case workloadSuccess:
+		return success(status)
+	case workloadFailure:
+		return failure(status)
+	default:
+		return orNone(status)
+	}
+}
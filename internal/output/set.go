@@ -0,0 +1,179 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/diffutil"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"gopkg.in/yaml.v3"
+)
+
+// Envelope kinds of deployment sets
+const (
+	KindDeploymentSet     = "DeploymentSet"
+	KindDeploymentSetList = "DeploymentSetList"
+)
+
+// FormatSets formats a list of deployment sets in the specified format.
+// Table formats list the workloads and shared resources of each set.
+func FormatSets(sets []humanitec.DeploymentSet, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		var sb strings.Builder
+		for _, set := range sets {
+			line, err := marshal(set, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
+		}
+		return sb.String(), nil
+
+	case FormatJSON, FormatYAML:
+		if sets == nil {
+			sets = []humanitec.DeploymentSet{}
+		}
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindDeploymentSetList, Items: sets}, format)
+
+	case FormatTable:
+		var sb strings.Builder
+		sb.WriteString(header("ID\tWORKLOADS\tSHARED") + "\n")
+		sb.WriteString("--\t---------\t------\n")
+		for _, s := range sets {
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%s\n", s.ID, workloadList(s.Modules), workloadList(s.Shared)))
+		}
+		return sb.String(), nil
+
+	case FormatWide:
+		var sb strings.Builder
+		sb.WriteString(header("ID\tWORKLOADS\tSHARED\tVERSION") + "\n")
+		sb.WriteString("--\t---------\t------\t-------\n")
+		for _, s := range sets {
+			sb.WriteString(fmt.Sprintf("%s\t%s\t%s\t%d\n", s.ID, workloadList(s.Modules), workloadList(s.Shared), s.Version))
+		}
+		return sb.String(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// FormatSet formats a single deployment set in the specified format
+func FormatSet(set *humanitec.DeploymentSet, format Format) (string, error) {
+	switch format {
+	case FormatNDJSON:
+		return marshal(set, format)
+
+	case FormatJSON, FormatYAML:
+		return marshal(Envelope{APIVersion: APIVersion, Kind: KindDeploymentSet, Item: set}, format)
+
+	case FormatTable, FormatWide:
+		return FormatSets([]humanitec.DeploymentSet{*set}, format)
+
+	default:
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// setContent is the part of a deployment set that set diffs compare: its ID is a hash of
+// this content, so it is left out
+type setContent struct {
+	Modules map[string]interface{} `json:"modules" yaml:"modules"`
+	Shared  map[string]interface{} `json:"shared,omitempty" yaml:"shared,omitempty"`
+}
+
+// content returns the content of a set; a nil set has no workloads or shared resources
+func content(set *humanitec.DeploymentSet) setContent {
+	if set == nil {
+		return setContent{Modules: map[string]interface{}{}}
+	}
+	c := setContent{Modules: set.Modules, Shared: set.Shared}
+	if c.Modules == nil {
+		c.Modules = map[string]interface{}{}
+	}
+	return c
+}
+
+// SetPatch returns the JSON Patch operations that turn the content of one deployment set
+// into another. A nil set is treated as an empty one.
+func SetPatch(from, to *humanitec.DeploymentSet) ([]diffutil.PatchOp, error) {
+	return diffutil.JSONPatch(content(from), content(to))
+}
+
+// FormatSetDiff formats the differences between two deployment sets, where a nil set is
+// treated as an empty one. Machine formats print a JSON Patch from one set to the other.
+// Table formats summarize the added (+), changed (~) and removed (-) workloads and shared
+// resources followed by a unified diff of both sets, and print nothing if the sets are equal.
+func FormatSetDiff(from, to *humanitec.DeploymentSet, fromName, toName string, format Format) (string, error) {
+	if IsMachineFormat(format) {
+		ops, err := SetPatch(from, to)
+		if err != nil {
+			return "", err
+		}
+		return FormatPatch(ops, format)
+	}
+
+	if format != FormatTable && format != FormatWide {
+		return "", fmt.Errorf("unsupported format: %s", format)
+	}
+
+	fromContent, toContent := content(from), content(to)
+	fromYAML, err := yaml.Marshal(fromContent)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal to YAML: %w", err)
+	}
+	toYAML, err := yaml.Marshal(toContent)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal to YAML: %w", err)
+	}
+	unified, err := diffutil.Unified(string(fromYAML), string(toYAML), fromName, toName)
+	if err != nil {
+		return "", err
+	}
+	if unified == "" {
+		return "", nil
+	}
+
+	var sb strings.Builder
+	summarize(&sb, "Workloads:", fromContent.Modules, toContent.Modules)
+	summarize(&sb, "Shared resources:", fromContent.Shared, toContent.Shared)
+	sb.WriteString("\n")
+	sb.WriteString(FormatUnifiedDiff(unified))
+	return sb.String(), nil
+}
+
+// summarize writes a section listing the added, changed and removed entries of two maps,
+// or nothing if the maps are equal
+func summarize(sb *strings.Builder, title string, from, to map[string]interface{}) {
+	ids := map[string]interface{}{}
+	for id := range from {
+		ids[id] = nil
+	}
+	for id := range to {
+		ids[id] = nil
+	}
+
+	var lines []string
+	for _, id := range sortedIDs(ids) {
+		fromValue, inFrom := from[id]
+		toValue, inTo := to[id]
+		switch {
+		case !inFrom:
+			lines = append(lines, "  "+success("+ "+id))
+		case !inTo:
+			lines = append(lines, "  "+failure("- "+id))
+		default:
+			if ops, err := diffutil.JSONPatch(fromValue, toValue); err != nil || len(ops) > 0 {
+				lines = append(lines, "  ~ "+id)
+			}
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+	sb.WriteString(header(title) + "\n")
+	for _, line := range lines {
+		sb.WriteString(line + "\n")
+	}
+}
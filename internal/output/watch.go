@@ -0,0 +1,96 @@
+package output
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/watch"
+)
+
+// KindEvent is the envelope kind of a watch event
+const KindEvent = "Event"
+
+// ClearScreen moves the cursor to the top left corner and clears the terminal
+const ClearScreen = "\x1b[H\x1b[2J"
+
+// FormatEvents formats watch events in the specified format. NDJSON writes one event per
+// line, JSON writes one envelope per event and YAML separates the envelopes with "---".
+// Table formats render each object with table, the printer of a single-object table,
+// and prefix its row with the event type. The header is only included if withHeader is set.
+func FormatEvents(events []watch.Event, format Format, table func(obj interface{}) (string, error), withHeader bool) (string, error) {
+	var sb strings.Builder
+	for _, event := range events {
+		switch format {
+		case FormatNDJSON:
+			line, err := marshal(event, format)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(line)
+
+		case FormatJSON, FormatYAML:
+			doc, err := marshal(Envelope{APIVersion: APIVersion, Kind: KindEvent, Item: event}, format)
+			if err != nil {
+				return "", err
+			}
+			if format == FormatYAML {
+				sb.WriteString("---\n")
+			}
+			sb.WriteString(doc)
+
+		case FormatTable, FormatWide:
+			formatted, err := table(event.Object)
+			if err != nil {
+				return "", err
+			}
+			// The single-object table is a header, a separator and one row
+			lines := strings.SplitN(strings.TrimSuffix(formatted, "\n"), "\n", 3)
+			if len(lines) != 3 {
+				return "", fmt.Errorf("unexpected table output: %q", formatted)
+			}
+			if withHeader {
+				sb.WriteString(header("EVENT") + "\t" + lines[0] + "\n")
+				sb.WriteString("-----\t" + lines[1] + "\n")
+				withHeader = false
+			}
+			sb.WriteString(event.Type + "\t" + lines[2] + "\n")
+
+		default:
+			return "", fmt.Errorf("unsupported format: %s", format)
+		}
+	}
+	return sb.String(), nil
+}
+
+// Watch lists objects every interval until ctx is done and prints them to w in the specified
+// format, where table formats a list of objects like FormatApps. Objects are matched between
+// listings by key. On a terminal, table formats redraw the whole list on every change;
+// otherwise every change is printed as an event with FormatEvents.
+func Watch[T any](ctx context.Context, w io.Writer, interval time.Duration, list func() ([]T, error), key func(T) string,
+	table func([]T, Format) (string, error), format Format) error {
+	redraw := !IsMachineFormat(format) && IsTerminal(w)
+	row := func(obj interface{}) (string, error) {
+		return table([]T{obj.(T)}, format)
+	}
+	headerPrinted := false
+
+	return watch.Run(ctx, interval, list, key, func(events []watch.Event, items []T) error {
+		var formatted string
+		var err error
+		if redraw {
+			formatted, err = table(items, format)
+			formatted = ClearScreen + formatted
+		} else {
+			formatted, err = FormatEvents(events, format, row, !headerPrinted)
+			headerPrinted = headerPrinted || len(events) > 0
+		}
+		if err != nil {
+			return fmt.Errorf("failed to format output: %w", err)
+		}
+		fmt.Fprint(w, formatted)
+		return nil
+	})
+}
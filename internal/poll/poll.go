@@ -0,0 +1,38 @@
+// Package poll repeatedly checks a condition until it is met or a timeout expires.
+package poll
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrTimeout is returned when a condition is not met before the timeout expires
+var ErrTimeout = errors.New("timed out waiting for the condition")
+
+// ConditionFunc reports whether a condition is met. Returning an error stops polling.
+type ConditionFunc func() (done bool, err error)
+
+// Until checks cond immediately and then every interval until it is met, it
+// returns an error or timeout has elapsed. The last check happens at the deadline.
+func Until(timeout, interval time.Duration, cond ConditionFunc) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		done, err := cond()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("%w after %s", ErrTimeout, timeout)
+		}
+		if interval > remaining {
+			interval = remaining
+		}
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,72 @@
+package poll
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUntil verifies that Until checks the condition until it is met, fails or times out
+func TestUntil(t *testing.T) {
+	errCheck := errors.New("check failed")
+
+	testCases := []struct {
+		name           string
+		timeout        time.Duration
+		results        []bool
+		err            error
+		expectedErr    error
+		expectedChecks int
+	}{
+		{
+			name:           "met immediately",
+			timeout:        time.Second,
+			results:        []bool{true},
+			expectedChecks: 1,
+		},
+		{
+			name:           "met after retries",
+			timeout:        time.Second,
+			results:        []bool{false, false, true},
+			expectedChecks: 3,
+		},
+		{
+			name:           "error stops polling",
+			timeout:        time.Second,
+			results:        []bool{false},
+			err:            errCheck,
+			expectedErr:    errCheck,
+			expectedChecks: 1,
+		},
+		{
+			name:        "timeout",
+			timeout:     5 * time.Millisecond,
+			results:     []bool{false},
+			expectedErr: ErrTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checks := 0
+			err := Until(tc.timeout, time.Millisecond, func() (bool, error) {
+				result := tc.results[min(checks, len(tc.results)-1)]
+				checks++
+				return result, tc.err
+			})
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tc.expectedChecks > 0 {
+				assert.Equal(t, tc.expectedChecks, checks)
+			} else {
+				assert.Greater(t, checks, 1, "the condition should be checked until the deadline")
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Package prompt asks the user to confirm destructive operations.
+package prompt
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/output"
+)
+
+// ErrAborted is returned when the user does not confirm an operation
+var ErrAborted = errors.New("operation aborted: confirmation did not match")
+
+// IsInteractive reports whether the user can answer prompts on in.
+// It is a variable so tests can simulate a terminal.
+var IsInteractive = func(in io.Reader) bool {
+	return output.IsTerminal(in)
+}
+
+// Confirmer reads confirmations from the user. It keeps a single buffered
+// reader so that several prompts can be answered from the same input.
+type Confirmer struct {
+	in     *bufio.Reader
+	out    io.Writer
+	assume bool
+	ok     bool
+}
+
+// NewConfirmer creates a Confirmer reading answers from in and writing prompts
+// to out. When assumeYes is true every confirmation succeeds without prompting.
+func NewConfirmer(in io.Reader, out io.Writer, assumeYes bool) *Confirmer {
+	return &Confirmer{
+		in:     bufio.NewReader(in),
+		out:    out,
+		assume: assumeYes,
+		ok:     assumeYes || IsInteractive(in),
+	}
+}
+
+// Check returns an error if confirmations cannot be obtained, which is the
+// case when input is not a terminal and --yes was not given
+func (c *Confirmer) Check(action string) error {
+	if !c.ok {
+		return clierrors.Validationf("refusing to %s without confirmation: use --yes when not running in a terminal", action)
+	}
+	return nil
+}
+
+// ConfirmByTyping asks the user to type expected and reports whether they did
+func (c *Confirmer) ConfirmByTyping(expected string) (bool, error) {
+	if c.assume {
+		return true, nil
+	}
+	if err := c.Check("continue"); err != nil {
+		return false, err
+	}
+
+	fmt.Fprintf(c.out, "Type %q to confirm: ", expected)
+	answer, err := c.in.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("failed to read confirmation: %w", err)
+	}
+	return strings.TrimSpace(answer) == expected, nil
+}
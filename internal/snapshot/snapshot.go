@@ -0,0 +1,355 @@
+// Package snapshot captures the configuration of an application and recreates
+// it under a new application ID. It is the basis of the clone, export, import
+// and migrate commands.
+package snapshot
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/diffutil"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+)
+
+// Snapshot is the configuration of an application that can be recreated elsewhere
+type Snapshot struct {
+	App          humanitec.App           `json:"app" yaml:"app"`
+	Environments []humanitec.Environment `json:"environments" yaml:"environments"`
+	Values       []humanitec.Value       `json:"values" yaml:"values"`
+	// Sets holds the latest deployment set of each environment, keyed by environment ID
+	Sets map[string]*humanitec.DeploymentSet `json:"sets,omitempty" yaml:"sets,omitempty"`
+	// Pipelines holds the definitions of the pipelines of the application
+	Pipelines []PipelineDefinition `json:"pipelines,omitempty" yaml:"pipelines,omitempty"`
+}
+
+// PipelineDefinition is the YAML definition of a pipeline
+type PipelineDefinition struct {
+	ID         string `json:"id" yaml:"id"`
+	Definition string `json:"definition" yaml:"definition"`
+}
+
+// Options controls what is captured and restored
+type Options struct {
+	// IncludeSets captures the latest deployment set of each environment
+	// and deploys it to the recreated environment
+	IncludeSets bool
+	// IncludePipelines captures the pipeline definitions of the application
+	IncludePipelines bool
+}
+
+// Capture reads the configuration of an application
+func Capture(client humanitec.Client, appID string, opts Options) (*Snapshot, error) {
+	app, err := client.GetApp(appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get app %s: %w", appID, err)
+	}
+
+	envs, err := client.GetEnvs(appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environments of app %s: %w", appID, err)
+	}
+
+	values, err := client.GetValues(appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shared values of app %s: %w", appID, err)
+	}
+
+	snap := &Snapshot{App: *app, Environments: envs, Values: values}
+
+	if opts.IncludeSets {
+		snap.Sets = make(map[string]*humanitec.DeploymentSet)
+		for _, env := range envs {
+			if env.LastDeploy == nil || env.LastDeploy.SetID == "" {
+				continue
+			}
+			set, err := client.GetSet(appID, env.LastDeploy.SetID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get deployment set of environment %s: %w", env.ID, err)
+			}
+			snap.Sets[env.ID] = set
+		}
+	}
+
+	if opts.IncludePipelines {
+		pipelines, err := client.GetPipelines(appID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pipelines of app %s: %w", appID, err)
+		}
+		for _, pipeline := range pipelines {
+			definition, err := client.GetPipelineDefinition(appID, pipeline.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get definition of pipeline %s: %w", pipeline.ID, err)
+			}
+			snap.Pipelines = append(snap.Pipelines, PipelineDefinition{ID: pipeline.ID, Definition: definition})
+		}
+	}
+	return snap, nil
+}
+
+// Step kinds reported while restoring
+const (
+	StepApp         = "app"
+	StepEnvironment = "environment"
+	StepValue       = "value"
+	StepDeployment  = "deployment"
+	StepPipeline    = "pipeline"
+)
+
+// Step statuses reported while restoring
+const (
+	StatusCreated  = "created"
+	StatusDeployed = "deployed"
+	StatusSkipped  = "skipped"
+	StatusDeleted  = "deleted"
+	StatusFailed   = "failed"
+)
+
+// Step reports the outcome of recreating a single object
+type Step struct {
+	Kind   string
+	ID     string
+	Status string
+	// Message explains a skipped or failed step
+	Message string
+	// DeployID is the ID of the deployment started by a deployment step
+	DeployID string
+}
+
+// Progress is called after every step of a restore
+type Progress func(Step)
+
+// Restore recreates the snapshot as a new application with the given ID and name.
+// If any step fails, the new application is deleted again, which removes every
+// environment, value, pipeline and deployment created under it.
+func Restore(client humanitec.Client, snap *Snapshot, id, name string, progress Progress) (*humanitec.App, error) {
+	if progress == nil {
+		progress = func(Step) {}
+	}
+
+	// Environments are recreated from the snapshot, so no default environment is created
+	app, err := client.CreateApp(id, name, true)
+	if err != nil {
+		progress(Step{Kind: StepApp, ID: id, Status: StatusFailed, Message: err.Error()})
+		return nil, fmt.Errorf("failed to create app %s: %w", id, err)
+	}
+	progress(Step{Kind: StepApp, ID: id, Status: StatusCreated})
+
+	if err := restoreContents(client, snap, id, progress); err != nil {
+		if rollbackErr := client.DeleteApp(id); rollbackErr != nil {
+			progress(Step{Kind: StepApp, ID: id, Status: StatusFailed, Message: "rollback failed: " + rollbackErr.Error()})
+			return nil, fmt.Errorf("%w (rollback of app %s failed: %v)", err, id, rollbackErr)
+		}
+		progress(Step{Kind: StepApp, ID: id, Status: StatusDeleted, Message: "rolled back after failure"})
+		return nil, err
+	}
+	return app, nil
+}
+
+// restoreContents recreates the environments, shared values, pipelines and deployments of the snapshot in appID
+func restoreContents(client humanitec.Client, snap *Snapshot, appID string, progress Progress) error {
+	for _, env := range snap.Environments {
+		// Deployments of the source app do not exist in the new app
+		env.FromDeployID = ""
+		if _, err := client.CreateEnv(appID, env); err != nil {
+			progress(Step{Kind: StepEnvironment, ID: env.ID, Status: StatusFailed, Message: err.Error()})
+			return fmt.Errorf("failed to create environment %s: %w", env.ID, err)
+		}
+		progress(Step{Kind: StepEnvironment, ID: env.ID, Status: StatusCreated})
+	}
+
+	for _, value := range snap.Values {
+		// The API never returns secret values, so they cannot be copied
+		if value.IsSecret {
+			progress(Step{Kind: StepValue, ID: value.Key, Status: StatusSkipped, Message: "secret values cannot be read"})
+			continue
+		}
+		if _, err := client.CreateValue(appID, value); err != nil {
+			progress(Step{Kind: StepValue, ID: value.Key, Status: StatusFailed, Message: err.Error()})
+			return fmt.Errorf("failed to create shared value %s: %w", value.Key, err)
+		}
+		progress(Step{Kind: StepValue, ID: value.Key, Status: StatusCreated})
+	}
+
+	for _, pipeline := range snap.Pipelines {
+		if _, err := client.CreatePipeline(appID, pipeline.Definition); err != nil {
+			progress(Step{Kind: StepPipeline, ID: pipeline.ID, Status: StatusFailed, Message: err.Error()})
+			return fmt.Errorf("failed to create pipeline %s: %w", pipeline.ID, err)
+		}
+		progress(Step{Kind: StepPipeline, ID: pipeline.ID, Status: StatusCreated})
+	}
+
+	// Deployment sets belong to the source app, so each one is recreated as a delta
+	// adding all of its workloads and shared resources and deployed from there
+	for _, env := range snap.Environments {
+		set, ok := snap.Sets[env.ID]
+		if !ok {
+			continue
+		}
+		shared, err := addShared(set.Shared)
+		var delta *humanitec.Delta
+		if err == nil {
+			delta, err = client.CreateDelta(appID, humanitec.Delta{
+				Metadata: humanitec.DeltaMetadata{EnvID: env.ID, Name: fmt.Sprintf("Copy of %s/%s", snap.App.ID, env.ID)},
+				Modules:  humanitec.DeltaModules{Add: set.Modules},
+				Shared:   shared,
+			})
+		}
+		var deployment *humanitec.Deployment
+		if err == nil {
+			deployment, err = client.CreateDeployment(appID, env.ID, humanitec.DeploymentRequest{
+				DeltaID: delta.ID,
+				Comment: fmt.Sprintf("Copied from deployment set %s of %s/%s", set.ID, snap.App.ID, env.ID),
+			})
+		}
+		if err != nil {
+			progress(Step{Kind: StepDeployment, ID: env.ID, Status: StatusFailed, Message: err.Error()})
+			return fmt.Errorf("failed to deploy environment %s: %w", env.ID, err)
+		}
+		progress(Step{Kind: StepDeployment, ID: env.ID, Status: StatusDeployed, DeployID: deployment.ID})
+	}
+	return nil
+}
+
+// addShared returns the delta operations that add every shared resource of a deployment set
+func addShared(shared map[string]interface{}) ([]interface{}, error) {
+	if len(shared) == 0 {
+		return nil, nil
+	}
+	ops, err := diffutil.JSONPatch(map[string]interface{}{}, shared)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy shared resources: %w", err)
+	}
+	var result []interface{}
+	for _, op := range ops {
+		result = append(result, op)
+	}
+	return result, nil
+}
+
+// Compare reports the differences between the environments, shared values, deployment
+// sets and pipelines of two snapshots, e.g. to verify a copy made by Restore. Secret values
+// are ignored because Restore cannot copy them. Deployment sets are compared by content,
+// since their IDs belong to the application they were deployed in, and only if want
+// includes them; pipelines are compared by ID.
+func Compare(want, got *Snapshot) []string {
+	var diffs []string
+
+	envs := make(map[string]humanitec.Environment, len(got.Environments))
+	for _, env := range got.Environments {
+		envs[env.ID] = env
+	}
+	for _, env := range want.Environments {
+		actual, ok := envs[env.ID]
+		switch {
+		case !ok:
+			diffs = append(diffs, fmt.Sprintf("environment %s is missing", env.ID))
+		case actual.Name != env.Name || actual.Type != env.Type:
+			diffs = append(diffs, fmt.Sprintf("environment %s is %s (%s), want %s (%s)", env.ID, actual.Name, actual.Type, env.Name, env.Type))
+		}
+		delete(envs, env.ID)
+	}
+	for _, env := range got.Environments {
+		if _, extra := envs[env.ID]; extra {
+			diffs = append(diffs, fmt.Sprintf("environment %s is unexpected", env.ID))
+		}
+	}
+
+	values := make(map[string]humanitec.Value, len(got.Values))
+	for _, value := range got.Values {
+		if !value.IsSecret {
+			values[value.Key] = value
+		}
+	}
+	for _, value := range want.Values {
+		if value.IsSecret {
+			continue
+		}
+		actual, ok := values[value.Key]
+		switch {
+		case !ok:
+			diffs = append(diffs, fmt.Sprintf("value %s is missing", value.Key))
+		case actual.Value != value.Value || actual.Description != value.Description:
+			diffs = append(diffs, fmt.Sprintf("value %s is %q, want %q", value.Key, actual.Value, value.Value))
+		}
+		delete(values, value.Key)
+	}
+	for _, value := range got.Values {
+		if _, extra := values[value.Key]; extra {
+			diffs = append(diffs, fmt.Sprintf("value %s is unexpected", value.Key))
+		}
+	}
+
+	if want.Sets != nil {
+		diffs = append(diffs, compareSets(want.Sets, got.Sets)...)
+	}
+	return append(diffs, comparePipelines(want.Pipelines, got.Pipelines)...)
+}
+
+// compareSets reports the environments whose deployment sets differ in workloads or
+// shared resources
+func compareSets(want, got map[string]*humanitec.DeploymentSet) []string {
+	var diffs []string
+	for _, envID := range sortedEnvIDs(want, got) {
+		wantSet, inWant := want[envID]
+		gotSet, inGot := got[envID]
+		switch {
+		case !inGot:
+			diffs = append(diffs, fmt.Sprintf("deployment set of environment %s is missing", envID))
+		case !inWant:
+			diffs = append(diffs, fmt.Sprintf("deployment set of environment %s is unexpected", envID))
+		default:
+			ops, err := diffutil.JSONPatch(setContent(wantSet), setContent(gotSet))
+			if err != nil || len(ops) > 0 {
+				diffs = append(diffs, fmt.Sprintf("deployment set of environment %s differs", envID))
+			}
+		}
+	}
+	return diffs
+}
+
+// setContent returns the workloads and shared resources of a set without its ID, which is
+// a hash of the content
+func setContent(set *humanitec.DeploymentSet) humanitec.DeploymentSet {
+	content := humanitec.DeploymentSet{Modules: set.Modules, Shared: set.Shared}
+	if content.Modules == nil {
+		content.Modules = map[string]interface{}{}
+	}
+	return content
+}
+
+// sortedEnvIDs returns the environment IDs of two set maps in order
+func sortedEnvIDs(a, b map[string]*humanitec.DeploymentSet) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	var ids []string
+	for _, m := range []map[string]*humanitec.DeploymentSet{a, b} {
+		for id := range m {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// comparePipelines reports the pipelines that are missing from or unexpected in got
+func comparePipelines(want, got []PipelineDefinition) []string {
+	var diffs []string
+	ids := make(map[string]bool, len(got))
+	for _, pipeline := range got {
+		ids[pipeline.ID] = true
+	}
+	for _, pipeline := range want {
+		if !ids[pipeline.ID] {
+			diffs = append(diffs, fmt.Sprintf("pipeline %s is missing", pipeline.ID))
+		}
+		delete(ids, pipeline.ID)
+	}
+	for _, pipeline := range got {
+		if ids[pipeline.ID] {
+			diffs = append(diffs, fmt.Sprintf("pipeline %s is unexpected", pipeline.ID))
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,77 @@
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/humanitec"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCompare verifies that Compare reports differing environments, shared values,
+// deployment sets and pipelines, and ignores secret values and set IDs
+func TestCompare(t *testing.T) {
+	want := &Snapshot{
+		Environments: []humanitec.Environment{{ID: "development", Name: "Development", Type: "development"}},
+		Values:       []humanitec.Value{{Key: "REGION", Value: "eu-west-1"}, {Key: "API_KEY", IsSecret: true}},
+		Sets: map[string]*humanitec.DeploymentSet{
+			"development": {ID: "set-1", Modules: map[string]interface{}{"api": map[string]interface{}{}}},
+		},
+		Pipelines: []PipelineDefinition{{ID: "default"}},
+	}
+
+	testCases := []struct {
+		name     string
+		got      *Snapshot
+		expected []string
+	}{
+		{
+			name: "equal",
+			got: &Snapshot{
+				Environments: []humanitec.Environment{{ID: "development", Name: "Development", Type: "development"}},
+				Values:       []humanitec.Value{{Key: "REGION", Value: "eu-west-1"}},
+				Sets: map[string]*humanitec.DeploymentSet{
+					"development": {ID: "set-2", Modules: map[string]interface{}{"api": map[string]interface{}{}}},
+				},
+				Pipelines: []PipelineDefinition{{ID: "default"}},
+			},
+		},
+		{
+			name: "different",
+			got: &Snapshot{
+				Environments: []humanitec.Environment{{ID: "staging", Name: "Staging", Type: "staging"}},
+				Values:       []humanitec.Value{{Key: "REGION", Value: "us-east-1"}},
+				Sets: map[string]*humanitec.DeploymentSet{
+					"staging": {ID: "set-2", Modules: map[string]interface{}{"api": map[string]interface{}{}}},
+				},
+				Pipelines: []PipelineDefinition{{ID: "release"}},
+			},
+			expected: []string{
+				"environment development is missing",
+				"environment staging is unexpected",
+				`value REGION is "us-east-1", want "eu-west-1"`,
+				"deployment set of environment development is missing",
+				"deployment set of environment staging is unexpected",
+				"pipeline default is missing",
+				"pipeline release is unexpected",
+			},
+		},
+		{
+			name: "different set content",
+			got: &Snapshot{
+				Environments: []humanitec.Environment{{ID: "development", Name: "Development", Type: "development"}},
+				Values:       []humanitec.Value{{Key: "REGION", Value: "eu-west-1"}},
+				Sets: map[string]*humanitec.DeploymentSet{
+					"development": {ID: "set-1", Modules: map[string]interface{}{"api": map[string]interface{}{}}, Shared: map[string]interface{}{"dns": map[string]interface{}{}}},
+				},
+				Pipelines: []PipelineDefinition{{ID: "default"}},
+			},
+			expected: []string{"deployment set of environment development differs"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Compare(want, tc.got))
+		})
+	}
+}
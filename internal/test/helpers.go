@@ -26,6 +26,10 @@ package test
 
 import (
 	"bytes"
+	"context"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
@@ -41,6 +45,26 @@ var CommonTestArgs = []string{}
 // ExecuteCommand executes a cobra command with the given arguments and flags
 func ExecuteCommand(t *testing.T, root *cobra.Command, cmd *cobra.Command, args []string, flags map[string]string) (string, error) {
 	t.Helper()
+	return ExecuteCommandWithInput(t, root, cmd, args, flags, "")
+}
+
+// ExecuteCommandWithInput executes a cobra command like ExecuteCommand, reading
+// standard input from input, e.g. to answer confirmation prompts
+func ExecuteCommandWithInput(t *testing.T, root *cobra.Command, cmd *cobra.Command, args []string, flags map[string]string, input string) (string, error) {
+	t.Helper()
+	return execute(t, context.Background(), root, cmd, args, flags, input)
+}
+
+// ExecuteCommandContext executes a cobra command like ExecuteCommand with the given
+// context, e.g. to stop commands that run until interrupted
+func ExecuteCommandContext(t *testing.T, ctx context.Context, root *cobra.Command, cmd *cobra.Command, args []string, flags map[string]string) (string, error) {
+	t.Helper()
+	return execute(t, ctx, root, cmd, args, flags, "")
+}
+
+// execute runs cmd below a fresh copy of root and returns its standard output
+func execute(t *testing.T, ctx context.Context, root *cobra.Command, cmd *cobra.Command, args []string, flags map[string]string, input string) (string, error) {
+	t.Helper()
 
 	// Create separate buffers for stdout and stderr
 	stdout := new(bytes.Buffer)
@@ -53,6 +77,7 @@ func ExecuteCommand(t *testing.T, root *cobra.Command, cmd *cobra.Command, args
 	}
 	testRoot.SetOut(stdout)
 	testRoot.SetErr(stderr)
+	testRoot.SetIn(strings.NewReader(input))
 
 	// Create fresh copies of the commands
 	freshRoot := &cobra.Command{
@@ -62,6 +87,7 @@ func ExecuteCommand(t *testing.T, root *cobra.Command, cmd *cobra.Command, args
 	freshCmd := &cobra.Command{
 		Use:   cmd.Use,
 		Short: cmd.Short,
+		Args:  cmd.Args,
 		RunE:  cmd.RunE,
 	}
 
@@ -73,6 +99,10 @@ func ExecuteCommand(t *testing.T, root *cobra.Command, cmd *cobra.Command, args
 		flags[constants.OutputFlagName] = constants.DefaultOutputFormat
 	}
 
+	// The fresh flags share their values with the original command, so reset
+	// them to make sure values set by a previous test case do not leak
+	resetFlags(cmd)
+
 	// Copy flags from original command to fresh command
 	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
 		// Create a new flag with the same properties
@@ -108,28 +138,62 @@ func ExecuteCommand(t *testing.T, root *cobra.Command, cmd *cobra.Command, args
 	}
 
 	// Set arguments, prepending the command name
-	cmdArgs := append([]string{freshRoot.Use, freshCmd.Use}, args...)
+	cmdArgs := append([]string{freshRoot.Name(), freshCmd.Name()}, args...)
 	testRoot.SetArgs(cmdArgs)
 
 	// Set a dummy token to pass validation
 	config.SetConfig(config.Config{
 		HumanitecToken: "test-token",
+		HumanitecOrg:   flags[constants.OrgFlagName],
 		DefaultOutput:  flags[constants.OutputFlagName],
 	})
 
 	// Execute the command
-	err := testRoot.Execute()
+	err := testRoot.ExecuteContext(ctx)
 
 	// Return only the stdout content
 	return stdout.String(), err
 }
 
+// resetFlags restores every flag of cmd to its default value
+func resetFlags(cmd *cobra.Command) {
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if slice, ok := flag.Value.(pflag.SliceValue); ok {
+			_ = slice.Replace(nil)
+			return
+		}
+		_ = flag.Value.Set(flag.DefValue)
+	})
+}
+
 // SetupMockClient sets up a mock client for testing
 func SetupMockClient(t *testing.T, mockClient *MockClient) {
 	t.Helper()
 	humanitec.SetClientFactory(&MockClientFactory{mockClient})
 }
 
+// NewTemplateMockClient returns a mock client with a "template" application to copy: one
+// deployed development environment, a plain and a secret shared value, and a deployment set.
+// Copies are created as "new-app" and their deployments succeed. Tests override the fields
+// they need on the returned client.
+func NewTemplateMockClient() *MockClient {
+	return &MockClient{
+		App: &humanitec.App{ID: "new-app", Name: "New App"},
+		AppsByID: map[string]*humanitec.App{
+			"template": {ID: "template", Name: "Template"},
+		},
+		Envs: []humanitec.Environment{
+			{ID: "development", Name: "Development", Type: "development", LastDeploy: &humanitec.Deployment{ID: "deploy-1", SetID: "set-1"}},
+		},
+		Values: []humanitec.Value{
+			{Key: "REGION", Value: "eu-west-1"},
+			{Key: "API_KEY", IsSecret: true},
+		},
+		Set:         &humanitec.DeploymentSet{ID: "set-1", Modules: map[string]interface{}{"api": map[string]interface{}{}}},
+		Deployments: []humanitec.Deployment{{ID: "deploy-development", EnvID: "development", Status: humanitec.DeploymentSucceeded}},
+	}
+}
+
 // MockClientFactory is a mock implementation of ClientFactory
 type MockClientFactory struct {
 	client *MockClient
@@ -142,9 +206,85 @@ func (f *MockClientFactory) NewClient(token, org string) humanitec.Client {
 
 // MockClient is a mock implementation of Client
 type MockClient struct {
-	App   *humanitec.App
-	Apps  []humanitec.App
-	Error error
+	App       *humanitec.App
+	Apps      []humanitec.App
+	Envs      []humanitec.Environment
+	Values    []humanitec.Value
+	Pipelines []humanitec.Pipeline
+	Error     error
+	// AppErrors holds errors returned for specific application IDs
+	AppErrors map[string]error
+	// AppsByID holds apps returned by GetApp for specific IDs instead of App
+	AppsByID map[string]*humanitec.App
+	// AppLists are returned by successive calls to GetApps instead of Apps;
+	// the last list is returned once all others have been
+	AppLists [][]humanitec.App
+	// Set is returned by GetSet
+	Set *humanitec.DeploymentSet
+	// Sets are listed by GetSets and looked up by ID in GetSet before falling back to Set
+	Sets map[string]*humanitec.DeploymentSet
+	// PipelineDefinition is returned by GetPipelineDefinition
+	PipelineDefinition string
+	// ValuesError is returned by GetValues
+	ValuesError error
+	// PipelinesError is returned by GetPipelines
+	PipelinesError error
+	// CreateErrors holds errors returned when creating the environment or
+	// shared value with the given ID or key
+	CreateErrors map[string]error
+	// Deleted records the IDs of the applications passed to DeleteApp
+	Deleted []string
+	// Created records the IDs of the applications passed to CreateApp
+	Created []string
+	// Updated records the IDs of the applications passed to UpdateApp
+	Updated []string
+	// Paused holds the paused state of environments by "app/env" and records
+	// the changes made by SetEnvPaused
+	Paused map[string]bool
+	// ModuleRuntimes are returned as the runtime modules of successive calls to
+	// GetEnvRuntime; the last one is returned once all others have been
+	ModuleRuntimes []map[string]humanitec.ModuleRuntime
+	// EnvsByApp holds environments returned by GetEnvs for specific applications instead of Envs
+	EnvsByApp map[string][]humanitec.Environment
+	// EnvLists are returned by successive calls to GetEnvs instead of Envs;
+	// the last list is returned once all others have been
+	EnvLists [][]humanitec.Environment
+	// Deployments are returned by GetDeployments and looked up by GetDeployment
+	Deployments []humanitec.Deployment
+	// DeploymentLists are returned by successive calls to GetDeployments instead of Deployments;
+	// the last list is returned once all others have been
+	DeploymentLists [][]humanitec.Deployment
+	// DeploymentStatuses are the statuses of successive calls to GetDeployment;
+	// the last status is returned once all others have been
+	DeploymentStatuses []string
+	// DeploymentErrors are returned by GetDeploymentErrors
+	DeploymentErrors []humanitec.DeploymentError
+	// DeploymentLogs are returned by GetDeploymentLogs
+	DeploymentLogs []humanitec.DeploymentLog
+	// EnvTypes are returned by GetEnvTypes
+	EnvTypes []humanitec.EnvironmentType
+	// EnvTypeLists are returned by successive calls to GetEnvTypes instead of EnvTypes;
+	// the last list is returned once all others have been
+	EnvTypeLists [][]humanitec.EnvironmentType
+	// DeletedEnvs records the IDs of the environments passed to DeleteEnv
+	DeletedEnvs []string
+	// CreatedDeployments records the requests passed to CreateDeployment
+	CreatedDeployments []humanitec.DeploymentRequest
+	// ValuesByApp holds shared values returned by GetValues for specific applications instead of Values
+	ValuesByApp map[string][]humanitec.Value
+	// Deltas are filtered by GetDeltas and looked up by GetDelta
+	Deltas []humanitec.Delta
+	// DeltaLists are filtered by successive calls to GetDeltas instead of Deltas;
+	// the last list is filtered once all others have been
+	DeltaLists [][]humanitec.Delta
+	// CreatedDeltas records the deltas passed to CreateDelta
+	CreatedDeltas []humanitec.Delta
+	// UpdatedDeltas records the deltas passed to UpdateDelta
+	UpdatedDeltas []humanitec.Delta
+	// ArchivedDeltas records the IDs of the deltas passed to ArchiveDelta
+	ArchivedDeltas []string
+
+	mu sync.Mutex
 }
 
 // GetApps returns the mock apps
@@ -152,14 +292,47 @@ func (c *MockClient) GetApps() ([]humanitec.App, error) {
 	if c.Error != nil {
 		return nil, c.Error
 	}
+	if len(c.AppLists) > 0 {
+		return nextList(&c.mu, &c.AppLists), nil
+	}
 	return c.Apps, nil
 }
 
+// nextList returns the first of the successive lists and drops it unless it is the last one
+func nextList[T any](mu *sync.Mutex, lists *[][]T) []T {
+	mu.Lock()
+	defer mu.Unlock()
+	list := (*lists)[0]
+	if len(*lists) > 1 {
+		*lists = (*lists)[1:]
+	}
+	return list
+}
+
+// WalkApps calls fn for each mock app
+func (c *MockClient) WalkApps(fn func(humanitec.App) error) error {
+	if c.Error != nil {
+		return c.Error
+	}
+	for _, app := range c.Apps {
+		if err := fn(app); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetApp returns the mock app
 func (c *MockClient) GetApp(name string) (*humanitec.App, error) {
 	if c.Error != nil {
 		return nil, c.Error
 	}
+	if err := c.AppErrors[name]; err != nil {
+		return nil, err
+	}
+	if app, ok := c.AppsByID[name]; ok {
+		return app, nil
+	}
 	return c.App, nil
 }
 
@@ -168,12 +341,24 @@ func (c *MockClient) CreateApp(id string, name string, skipEnvCreation bool) (*h
 	if c.Error != nil {
 		return nil, c.Error
 	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Created = append(c.Created, id)
 	return c.App, nil
 }
 
-// DeleteApp returns the mock error
+// DeleteApp records the deleted app and returns the mock error
 func (c *MockClient) DeleteApp(name string) error {
-	return c.Error
+	if c.Error != nil {
+		return c.Error
+	}
+	if err := c.AppErrors[name]; err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Deleted = append(c.Deleted, name)
+	return nil
 }
 
 // UpdateApp returns the mock app
@@ -181,8 +366,349 @@ func (c *MockClient) UpdateApp(oldName string, newName string) (*humanitec.App,
 	if c.Error != nil {
 		return nil, c.Error
 	}
+	if err := c.AppErrors[oldName]; err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.Updated = append(c.Updated, oldName)
+	c.mu.Unlock()
 	// Create a copy of the mock app with the new name
 	updatedApp := *c.App
 	updatedApp.Name = newName
 	return &updatedApp, nil
-} 
\ No newline at end of file
+} 
+
+// GetEnvs returns the mock environments
+func (c *MockClient) GetEnvs(appID string) ([]humanitec.Environment, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	if envs, ok := c.EnvsByApp[appID]; ok {
+		return envs, nil
+	}
+	if len(c.EnvLists) > 0 {
+		return nextList(&c.mu, &c.EnvLists), nil
+	}
+	return c.Envs, nil
+}
+
+// GetEnv returns the mock environment with the given ID
+func (c *MockClient) GetEnv(appID, envID string) (*humanitec.Environment, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	for _, env := range c.Envs {
+		if env.ID == envID {
+			return &env, nil
+		}
+	}
+	return nil, &humanitec.APIError{StatusCode: 404, Message: "environment not found"}
+}
+
+// UpdateEnv returns the mock environment with the given ID and the new name
+func (c *MockClient) UpdateEnv(appID, envID, name string) (*humanitec.Environment, error) {
+	env, err := c.GetEnv(appID, envID)
+	if err != nil {
+		return nil, err
+	}
+	env.Name = name
+	return env, nil
+}
+
+// DeleteEnv records the deleted environment
+func (c *MockClient) DeleteEnv(appID, envID string) error {
+	if _, err := c.GetEnv(appID, envID); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.DeletedEnvs = append(c.DeletedEnvs, envID)
+	return nil
+}
+
+// GetEnvRuntime returns the mock paused state and module runtimes of the environment
+func (c *MockClient) GetEnvRuntime(appID, envID string) (*humanitec.EnvRuntime, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	runtime := &humanitec.EnvRuntime{Namespace: appID + "-" + envID, Paused: c.Paused[appID+"/"+envID]}
+	if len(c.ModuleRuntimes) > 0 {
+		runtime.Modules = c.ModuleRuntimes[0]
+		if len(c.ModuleRuntimes) > 1 {
+			c.ModuleRuntimes = c.ModuleRuntimes[1:]
+		}
+	}
+	return runtime, nil
+}
+
+// SetEnvPaused records the paused state of the environment
+func (c *MockClient) SetEnvPaused(appID, envID string, paused bool) error {
+	if c.Error != nil {
+		return c.Error
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.Paused == nil {
+		c.Paused = map[string]bool{}
+	}
+	c.Paused[appID+"/"+envID] = paused
+	return nil
+}
+
+// CreateEnv returns the given environment
+func (c *MockClient) CreateEnv(appID string, env humanitec.Environment) (*humanitec.Environment, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	if err := c.CreateErrors[env.ID]; err != nil {
+		return nil, err
+	}
+	return &env, nil
+}
+
+// GetEnvTypes returns the mock environment types
+func (c *MockClient) GetEnvTypes() ([]humanitec.EnvironmentType, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	if len(c.EnvTypeLists) > 0 {
+		return nextList(&c.mu, &c.EnvTypeLists), nil
+	}
+	return c.EnvTypes, nil
+}
+
+// CreateEnvType returns the given environment type
+func (c *MockClient) CreateEnvType(envType humanitec.EnvironmentType) (*humanitec.EnvironmentType, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	if err := c.CreateErrors[envType.ID]; err != nil {
+		return nil, err
+	}
+	return &envType, nil
+}
+
+// DeleteEnvType returns a not found error for unknown environment types
+func (c *MockClient) DeleteEnvType(id string) error {
+	if c.Error != nil {
+		return c.Error
+	}
+	for _, envType := range c.EnvTypes {
+		if envType.ID == id {
+			return nil
+		}
+	}
+	return &humanitec.APIError{StatusCode: 404, Message: "environment type not found"}
+}
+
+// GetValues returns the mock shared values
+func (c *MockClient) GetValues(appID string) ([]humanitec.Value, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	if c.ValuesError != nil {
+		return nil, c.ValuesError
+	}
+	if values, ok := c.ValuesByApp[appID]; ok {
+		return values, nil
+	}
+	return c.Values, nil
+}
+
+// CreateValue returns the given shared value
+func (c *MockClient) CreateValue(appID string, value humanitec.Value) (*humanitec.Value, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	if err := c.CreateErrors[value.Key]; err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// GetPipelines returns the mock pipelines
+func (c *MockClient) GetPipelines(appID string) ([]humanitec.Pipeline, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	if c.PipelinesError != nil {
+		return nil, c.PipelinesError
+	}
+	return c.Pipelines, nil
+}
+
+// GetPipelineDefinition returns the mock pipeline definition
+func (c *MockClient) GetPipelineDefinition(appID, pipelineID string) (string, error) {
+	if c.Error != nil {
+		return "", c.Error
+	}
+	return c.PipelineDefinition, nil
+}
+
+// CreatePipeline returns a pipeline of the given app
+func (c *MockClient) CreatePipeline(appID, definition string) (*humanitec.Pipeline, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	return &humanitec.Pipeline{ID: "pipeline", AppID: appID}, nil
+}
+
+// GetSets returns the mock deployment sets of Sets, ordered by ID
+func (c *MockClient) GetSets(appID string) ([]humanitec.DeploymentSet, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	var sets []humanitec.DeploymentSet
+	for _, set := range c.Sets {
+		sets = append(sets, *set)
+	}
+	sort.Slice(sets, func(i, j int) bool { return sets[i].ID < sets[j].ID })
+	return sets, nil
+}
+
+// GetSet returns the mock deployment set
+func (c *MockClient) GetSet(appID, setID string) (*humanitec.DeploymentSet, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	if set, ok := c.Sets[setID]; ok {
+		return set, nil
+	}
+	if c.Set == nil {
+		return nil, &humanitec.APIError{StatusCode: 404, Message: "deployment set not found"}
+	}
+	return c.Set, nil
+}
+
+// GetDeltas returns the mock deltas of the given environment and archived state
+func (c *MockClient) GetDeltas(appID, envID string, archived bool) ([]humanitec.Delta, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	all := c.Deltas
+	if len(c.DeltaLists) > 0 {
+		all = nextList(&c.mu, &c.DeltaLists)
+	}
+	var deltas []humanitec.Delta
+	for _, delta := range all {
+		if (envID == "" || delta.Metadata.EnvID == envID) && delta.Metadata.Archived == archived {
+			deltas = append(deltas, delta)
+		}
+	}
+	return deltas, nil
+}
+
+// GetDelta returns the mock delta with the given ID
+func (c *MockClient) GetDelta(appID, deltaID string) (*humanitec.Delta, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	for _, delta := range c.Deltas {
+		if delta.ID == deltaID {
+			return &delta, nil
+		}
+	}
+	return nil, &humanitec.APIError{StatusCode: 404, Message: "delta not found"}
+}
+
+// CreateDelta returns the given delta with an ID derived from its environment
+func (c *MockClient) CreateDelta(appID string, delta humanitec.Delta) (*humanitec.Delta, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.CreatedDeltas = append(c.CreatedDeltas, delta)
+	delta.ID = "delta-" + delta.Metadata.EnvID
+	return &delta, nil
+}
+
+// UpdateDelta returns the given delta with the given ID
+func (c *MockClient) UpdateDelta(appID, deltaID string, delta humanitec.Delta) (*humanitec.Delta, error) {
+	if _, err := c.GetDelta(appID, deltaID); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.UpdatedDeltas = append(c.UpdatedDeltas, delta)
+	delta.ID = deltaID
+	return &delta, nil
+}
+
+// ArchiveDelta records the archived delta
+func (c *MockClient) ArchiveDelta(appID, deltaID string) error {
+	if _, err := c.GetDelta(appID, deltaID); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ArchivedDeltas = append(c.ArchivedDeltas, deltaID)
+	return nil
+}
+
+// GetDeployments returns the mock deployments
+func (c *MockClient) GetDeployments(appID, envID string) ([]humanitec.Deployment, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	if len(c.DeploymentLists) > 0 {
+		return nextList(&c.mu, &c.DeploymentLists), nil
+	}
+	return c.Deployments, nil
+}
+
+// GetDeployment returns the mock deployment with the given ID and the next mock status
+func (c *MockClient) GetDeployment(appID, envID, deployID string) (*humanitec.Deployment, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	for _, deployment := range c.Deployments {
+		if deployment.ID != deployID {
+			continue
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if len(c.DeploymentStatuses) > 0 {
+			deployment.Status = c.DeploymentStatuses[0]
+			if len(c.DeploymentStatuses) > 1 {
+				c.DeploymentStatuses = c.DeploymentStatuses[1:]
+			}
+		}
+		return &deployment, nil
+	}
+	return nil, &humanitec.APIError{StatusCode: 404, Message: "deployment not found"}
+}
+
+// GetDeploymentErrors returns the mock deployment errors
+func (c *MockClient) GetDeploymentErrors(appID, envID, deployID string) ([]humanitec.DeploymentError, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	return c.DeploymentErrors, nil
+}
+
+// GetDeploymentLogs returns the mock deployment logs if the deployment is one of Deployments
+func (c *MockClient) GetDeploymentLogs(appID, envID, deployID string) ([]humanitec.DeploymentLog, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	for _, deployment := range c.Deployments {
+		if deployment.ID == deployID {
+			return c.DeploymentLogs, nil
+		}
+	}
+	return nil, &humanitec.APIError{StatusCode: 404, Message: "deployment not found"}
+}
+
+// CreateDeployment returns a pending deployment of the given request
+func (c *MockClient) CreateDeployment(appID, envID string, req humanitec.DeploymentRequest) (*humanitec.Deployment, error) {
+	if c.Error != nil {
+		return nil, c.Error
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.CreatedDeployments = append(c.CreatedDeployments, req)
+	return &humanitec.Deployment{ID: "deploy-" + envID, EnvID: envID, DeltaID: req.DeltaID, SetID: req.SetID, Comment: req.Comment, Status: "pending"}, nil
+}
@@ -0,0 +1,145 @@
+// Package validation checks Humanitec identifiers and names on the client side,
+// so that invalid input is rejected before any API call is made.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/constants"
+)
+
+// IDPattern is the pattern every Humanitec identifier must match
+const IDPattern = `^[a-z0-9](?:-?[a-z0-9]+)+$`
+
+var idRegexp = regexp.MustCompile(IDPattern)
+
+// Kind describes the identifier rules of a Humanitec resource type
+type Kind struct {
+	// Name is the human-readable resource type used in error messages
+	Name string
+	// MaxLength is the maximum length of an identifier, or 0 if only the pattern is checked
+	MaxLength int
+}
+
+// Identifier rules of the supported resource types. Only application IDs have a documented
+// length limit, the one stated by the create app command; the API remains the authority
+// on the length of other identifiers.
+var (
+	App         = Kind{Name: "application", MaxLength: 50}
+	Environment = Kind{Name: "environment"}
+	EnvType     = Kind{Name: "environment type"}
+	Workload    = Kind{Name: "workload"}
+	ResourceDef = Kind{Name: "resource definition"}
+)
+
+// ID validates an identifier of the given kind. When the identifier is invalid
+// but can be turned into a valid one, the error suggests the slugified form.
+func ID(kind Kind, id string) error {
+	if id == "" {
+		return clierrors.Validationf(constants.ErrEmptyID, kind.Name)
+	}
+
+	var msg string
+	switch {
+	case kind.MaxLength > 0 && len(id) > kind.MaxLength:
+		msg = fmt.Sprintf(constants.ErrIDTooLong, kind.Name, id, kind.MaxLength)
+	case !idRegexp.MatchString(id):
+		msg = fmt.Sprintf(constants.ErrInvalidIDFormat, kind.Name, id, IDPattern)
+	default:
+		return nil
+	}
+
+	if slug := Slugify(kind, id); slug != "" && slug != id {
+		msg += fmt.Sprintf(constants.ErrIDSuggestion, slug)
+	}
+	return clierrors.NewValidation(errors.New(msg))
+}
+
+// Name validates a display name
+func Name(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return clierrors.Validationf(constants.ErrEmptyName)
+	}
+	return nil
+}
+
+// Slugify turns a display name into a valid identifier of the given kind by
+// lowercasing it, replacing every run of other characters with a single dash
+// and truncating it to the maximum length, if any. It returns "" if nothing remains.
+func Slugify(kind Kind, name string) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if dash && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			sb.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+
+	slug := sb.String()
+	if kind.MaxLength > 0 && len(slug) > kind.MaxLength {
+		slug = strings.TrimRight(slug[:kind.MaxLength], "-")
+	}
+	if !idRegexp.MatchString(slug) {
+		return ""
+	}
+	return slug
+}
+
+// OneOf validates that value is one of the allowed values of the given kind. When it is
+// not, the error lists the allowed values and suggests the closest one if it is similar.
+func OneOf(kind Kind, value string, allowed []string) error {
+	for _, candidate := range allowed {
+		if candidate == value {
+			return nil
+		}
+	}
+
+	msg := fmt.Sprintf(constants.ErrUnknownValue, kind.Name, value, strings.Join(allowed, ", "))
+	if suggestion := Closest(value, allowed); suggestion != "" {
+		msg += fmt.Sprintf(constants.ErrIDSuggestion, suggestion)
+	}
+	return clierrors.NewValidation(errors.New(msg))
+}
+
+// Closest returns the candidate with the smallest edit distance to value, or ""
+// if none is close enough to be a likely typo
+func Closest(value string, candidates []string) string {
+	best, bestDistance := "", len(value)/3+1
+	for _, candidate := range candidates {
+		if d := distance(strings.ToLower(value), strings.ToLower(candidate)); d <= bestDistance && (best == "" || d < bestDistance) {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// distance returns the Levenshtein distance between a and b
+func distance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
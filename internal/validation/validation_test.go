@@ -0,0 +1,139 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestID verifies that identifiers are checked against the pattern and the maximum length
+// of their kind, and that invalid identifiers get a suggestion where one exists
+func TestID(t *testing.T) {
+	testCases := []struct {
+		name          string
+		kind          Kind
+		id            string
+		expectedError string
+	}{
+		{
+			name: "valid application",
+			kind: App,
+			id:   "my-app-1",
+		},
+		{
+			name: "valid workload",
+			kind: Workload,
+			id:   "api",
+		},
+		{
+			name: "long environment id without a length limit",
+			kind: Environment,
+			id:   "a-very-long-environment-identifier-that-exceeds-fifty-characters",
+		},
+		{
+			name:          "empty",
+			kind:          Workload,
+			id:            "",
+			expectedError: "invalid workload id: must not be empty",
+		},
+		{
+			name:          "invalid characters",
+			kind:          App,
+			id:            "My_App",
+			expectedError: `invalid application id "My_App": must match pattern ` + IDPattern + ` (did you mean "my-app"?)`,
+		},
+		{
+			name:          "single character",
+			kind:          ResourceDef,
+			id:            "a",
+			expectedError: `invalid resource definition id "a": must match pattern ` + IDPattern,
+		},
+		{
+			name:          "double dash",
+			kind:          EnvType,
+			id:            "dev--eu",
+			expectedError: `invalid environment type id "dev--eu": must match pattern ` + IDPattern + ` (did you mean "dev-eu"?)`,
+		},
+		{
+			name:          "too long",
+			kind:          App,
+			id:            "a-very-long-application-identifier-that-exceeds-fifty",
+			expectedError: `invalid application id "a-very-long-application-identifier-that-exceeds-fifty": must be 50 characters or less (did you mean "a-very-long-application-identifier-that-exceeds-fi"?)`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ID(tc.kind, tc.id)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedError)
+			assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(err))
+		})
+	}
+}
+
+// TestSlugify verifies that names are turned into valid identifiers of the given kind
+func TestSlugify(t *testing.T) {
+	testCases := []struct {
+		name     string
+		kind     Kind
+		input    string
+		expected string
+	}{
+		{name: "lowercased", kind: App, input: "MyApp", expected: "myapp"},
+		{name: "separators collapsed", kind: App, input: "  My -- New_App!  ", expected: "my-new-app"},
+		{name: "truncated without trailing dash", kind: App, input: "a-very-long-application-identifier-that-exceeds-f-fifty", expected: "a-very-long-application-identifier-that-exceeds-f"},
+		{name: "not truncated without a length limit", kind: Workload, input: "A Very Long Workload Name That Exceeds Fifty Characters", expected: "a-very-long-workload-name-that-exceeds-fifty-characters"},
+		{name: "nothing left", kind: App, input: "!!!", expected: ""},
+		{name: "too short", kind: App, input: "a", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Slugify(tc.kind, tc.input))
+		})
+	}
+}
+
+// TestOneOf verifies that values outside the allowed set are rejected with the allowed
+// values and a suggestion for likely typos
+func TestOneOf(t *testing.T) {
+	allowed := []string{"development", "staging", "production"}
+
+	assert.NoError(t, OneOf(EnvType, "staging", allowed))
+
+	err := OneOf(EnvType, "prodution", allowed)
+	assert.EqualError(t, err, `unknown environment type "prodution": must be one of development, staging, production (did you mean "production"?)`)
+	assert.Equal(t, clierrors.ExitValidation, clierrors.ExitCode(err))
+
+	err = OneOf(EnvType, "qa", allowed)
+	assert.EqualError(t, err, `unknown environment type "qa": must be one of development, staging, production`)
+}
+
+// TestClosest verifies that the closest candidate is only suggested when it is close enough
+func TestClosest(t *testing.T) {
+	candidates := []string{"development", "staging", "production"}
+
+	testCases := []struct {
+		name       string
+		value      string
+		candidates []string
+		expected   string
+	}{
+		{name: "exact", value: "staging", candidates: candidates, expected: "staging"},
+		{name: "typo", value: "stagign", candidates: candidates, expected: "staging"},
+		{name: "case insensitive", value: "Production", candidates: candidates, expected: "production"},
+		{name: "too different", value: "qa", candidates: candidates, expected: ""},
+		{name: "no candidates", value: "staging", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Closest(tc.value, tc.candidates))
+		})
+	}
+}
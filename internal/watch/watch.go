@@ -0,0 +1,80 @@
+// Package watch polls a list of resources and reports what changed between listings.
+package watch
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// Event types
+const (
+	Added    = "ADDED"
+	Modified = "MODIFIED"
+	Deleted  = "DELETED"
+)
+
+// Event reports a change to a single object
+type Event struct {
+	Type   string      `json:"type" yaml:"type"`
+	Object interface{} `json:"object" yaml:"object"`
+}
+
+// Changes returns the events that turn prev into curr. Objects are matched by key
+// and compared by value. Added and modified objects are reported in the order of
+// curr, followed by deleted objects in the order of prev.
+func Changes[T any](prev, curr []T, key func(T) string) []Event {
+	previous := make(map[string]T, len(prev))
+	for _, item := range prev {
+		previous[key(item)] = item
+	}
+
+	var events []Event
+	current := make(map[string]bool, len(curr))
+	for _, item := range curr {
+		k := key(item)
+		current[k] = true
+		old, ok := previous[k]
+		switch {
+		case !ok:
+			events = append(events, Event{Type: Added, Object: item})
+		case !reflect.DeepEqual(old, item):
+			events = append(events, Event{Type: Modified, Object: item})
+		}
+	}
+	for _, item := range prev {
+		if !current[key(item)] {
+			events = append(events, Event{Type: Deleted, Object: item})
+		}
+	}
+	return events
+}
+
+// Run lists the objects every interval until ctx is done and calls fn with the
+// events since the previous listing and the current objects. The first listing
+// reports every object as added. fn is not called when nothing changed.
+func Run[T any](ctx context.Context, interval time.Duration, list func() ([]T, error), key func(T) string, fn func(events []Event, items []T) error) error {
+	var prev []T
+	first := true
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		items, err := list()
+		if err != nil {
+			return err
+		}
+		if events := Changes(prev, items, key); first || len(events) > 0 {
+			if err := fn(events, items); err != nil {
+				return err
+			}
+		}
+		prev, first = items, false
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
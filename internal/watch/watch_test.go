@@ -0,0 +1,110 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// item is an object with a key and a value to watch
+type item struct {
+	ID     string
+	Status string
+}
+
+func itemKey(i item) string {
+	return i.ID
+}
+
+// TestChanges verifies that added, modified and deleted objects are reported in order
+func TestChanges(t *testing.T) {
+	testCases := []struct {
+		name     string
+		prev     []item
+		curr     []item
+		expected []Event
+	}{
+		{
+			name:     "first listing",
+			curr:     []item{{ID: "a", Status: "ok"}, {ID: "b", Status: "ok"}},
+			expected: []Event{{Type: Added, Object: item{ID: "a", Status: "ok"}}, {Type: Added, Object: item{ID: "b", Status: "ok"}}},
+		},
+		{
+			name: "unchanged",
+			prev: []item{{ID: "a", Status: "ok"}},
+			curr: []item{{ID: "a", Status: "ok"}},
+		},
+		{
+			name: "added, modified and deleted",
+			prev: []item{{ID: "a", Status: "ok"}, {ID: "b", Status: "ok"}, {ID: "c", Status: "ok"}},
+			curr: []item{{ID: "d", Status: "ok"}, {ID: "b", Status: "failed"}, {ID: "c", Status: "ok"}},
+			expected: []Event{
+				{Type: Added, Object: item{ID: "d", Status: "ok"}},
+				{Type: Modified, Object: item{ID: "b", Status: "failed"}},
+				{Type: Deleted, Object: item{ID: "a", Status: "ok"}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Changes(tc.prev, tc.curr, itemKey))
+		})
+	}
+}
+
+// TestRun verifies that Run reports the first listing and later changes, skips listings
+// without changes and stops when the context is done
+func TestRun(t *testing.T) {
+	listings := [][]item{
+		{{ID: "a", Status: "pending"}},
+		{{ID: "a", Status: "pending"}},
+		{{ID: "a", Status: "ok"}},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	list := func() ([]item, error) {
+		listing := listings[min(calls, len(listings)-1)]
+		calls++
+		if calls == len(listings) {
+			cancel()
+		}
+		return listing, nil
+	}
+
+	var reported [][]Event
+	err := Run(ctx, time.Millisecond, list, itemKey, func(events []Event, items []item) error {
+		reported = append(reported, events)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]Event{
+		{{Type: Added, Object: item{ID: "a", Status: "pending"}}},
+		{{Type: Modified, Object: item{ID: "a", Status: "ok"}}},
+	}, reported)
+}
+
+// TestRunErrors verifies that Run stops at the first error of listing or reporting
+func TestRunErrors(t *testing.T) {
+	errList := errors.New("list failed")
+	err := Run(context.Background(), time.Millisecond, func() ([]item, error) {
+		return nil, errList
+	}, itemKey, func([]Event, []item) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, errList)
+
+	errReport := errors.New("report failed")
+	err = Run(context.Background(), time.Millisecond, func() ([]item, error) {
+		return []item{{ID: "a"}}, nil
+	}, itemKey, func([]Event, []item) error {
+		return errReport
+	})
+	assert.ErrorIs(t, err, errReport)
+}
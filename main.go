@@ -3,11 +3,12 @@ package main
 import (
 	"os"
 
+	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/clierrors"
 	"github.com/lil-yellow-flower/humctl-wrapper-demo/internal/commands"
 )
 
 func main() {
 	if err := commands.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(clierrors.ExitCode(err))
 	}
 } 
\ No newline at end of file